@@ -0,0 +1,74 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
+	"github.com/afiskon/promtail-client/promtail"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+const accommodationEventConsumer = "accommodation-events"
+
+const (
+	accommodationCreatedTopic = "accommodation.created"
+	accommodationUpdatedTopic = "accommodation.updated"
+	accommodationDeletedTopic = "accommodation.deleted"
+)
+
+var AccommodationEventTopics = []string{accommodationCreatedTopic, accommodationUpdatedTopic, accommodationDeletedTopic}
+
+// AccommodationEventConsumer keeps unavailability in step with the accommodation service alongside the AddUnavailability
+// and EditAccommodation gRPC calls, so that accommodation writes do not depend on this service being up.
+type AccommodationEventConsumer struct {
+	unavailabilityService     *UnavailabilityService
+	reservationRequestService *ReservationRequestService
+	processedEvents           domain.ProcessedEventStore
+	transactions              domain.TransactionManager
+	tracer                    trace.Tracer
+	loki                      promtail.Client
+}
+
+func NewAccommodationEventConsumer(unavailabilityService *UnavailabilityService, reservationRequestService *ReservationRequestService, processedEvents domain.ProcessedEventStore, transactions domain.TransactionManager, tracer trace.Tracer, loki promtail.Client) *AccommodationEventConsumer {
+	return &AccommodationEventConsumer{
+		unavailabilityService:     unavailabilityService,
+		reservationRequestService: reservationRequestService,
+		processedEvents:           processedEvents,
+		transactions:              transactions,
+		tracer:                    tracer,
+		loki:                      loki,
+	}
+}
+
+// Handle orders events of an accommodation by the time the accommodation service sent them, since its created, updated
+// and deleted topics are consumed independently.
+func (consumer *AccommodationEventConsumer) Handle(event domain.Event) error {
+	span := startConsumerSpan(consumer.tracer, event)
+	defer func() { span.End() }()
+
+	var accommodationEvent dto.AccommodationEventDto
+	if err := json.Unmarshal(event.Payload, &accommodationEvent); err != nil {
+		return &domain.ValidationError{Message: fmt.Sprintf("invalid %s event %s: %v", event.Topic, event.Id, err)}
+	}
+	accommodationId, err := primitive.ObjectIDFromHex(accommodationEvent.Id)
+	if err != nil {
+		return &domain.ValidationError{Message: fmt.Sprintf("invalid accommodation id %q in %s event %s", accommodationEvent.Id, event.Topic, event.Id)}
+	}
+
+	return consumeOnce(consumer.transactions, consumer.processedEvents, accommodationEventConsumer, event, span, consumer.loki, func(transaction domain.Transaction) error {
+		switch event.Topic {
+		case accommodationCreatedTopic, accommodationUpdatedTopic:
+			responseWindow := time.Duration(accommodationEvent.ResponseWindowHours) * time.Hour
+			return consumer.unavailabilityService.upsertAccommodation(transaction, accommodationId, accommodationEvent.AccommodationName, accommodationEvent.Automatically, accommodationEvent.HostId, responseWindow, event.Time, span, consumer.loki)
+		case accommodationDeletedTopic:
+			if err := consumer.reservationRequestService.checkAccommodationDeletable(accommodationId, span, consumer.loki); err != nil {
+				return err
+			}
+			return consumer.unavailabilityService.deleteAccommodation(transaction, accommodationId, event.Time, span, consumer.loki)
+		}
+		return &domain.ValidationError{Message: fmt.Sprintf("unexpected topic %s for event %s", event.Topic, event.Id)}
+	})
+}
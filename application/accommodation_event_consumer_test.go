@@ -0,0 +1,108 @@
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/trace"
+	"testing"
+	"time"
+)
+
+type accommodationEventFixture struct {
+	store           *fakeUnavailabilityStore
+	tombstones      *fakeAccommodationTombstoneStore
+	requests        *fakeReservationRequestStore
+	consumer        *AccommodationEventConsumer
+	accommodationId primitive.ObjectID
+}
+
+func newAccommodationEventFixture(requests ...*domain.ReservationRequest) *accommodationEventFixture {
+	fixture := &accommodationEventFixture{
+		store:           newFakeUnavailabilityStore(),
+		tombstones:      &fakeAccommodationTombstoneStore{},
+		requests:        newFakeReservationRequestStore(requests...),
+		accommodationId: primitive.NewObjectID(),
+	}
+	unavailabilityService := NewUnavailabilityService(fixture.store, fakeTransactionManager{}, &fakeOutboxStore{}, fixture.requests, nil, fixture.tombstones, noopLoki{})
+	reservationRequestService := NewReservationRequestService(fixture.requests, unavailabilityService, fakeTransactionManager{}, &fakeOutboxStore{}, noopLoki{})
+	fixture.consumer = NewAccommodationEventConsumer(unavailabilityService, reservationRequestService, &fakeProcessedEventStore{}, fakeTransactionManager{}, trace.NewNoopTracerProvider().Tracer(""), noopLoki{})
+	return fixture
+}
+
+func (fixture *accommodationEventFixture) handle(t *testing.T, topic string, name string, sentAt time.Time) error {
+	t.Helper()
+	payload, err := json.Marshal(dto.AccommodationEventDto{Id: fixture.accommodationId.Hex(), AccommodationName: name, HostId: "host"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fixture.consumer.Handle(domain.Event{Id: primitive.NewObjectID().Hex(), Topic: topic, Payload: payload, Time: sentAt})
+}
+
+func (fixture *accommodationEventFixture) getName(t *testing.T) string {
+	t.Helper()
+	unavailability, _ := fixture.store.GetByAccommodationId(fixture.accommodationId)
+	if unavailability == nil {
+		return ""
+	}
+	return unavailability.AccommodationName
+}
+
+func TestLateAccommodationEventsAreIgnored(t *testing.T) {
+	fixture := newAccommodationEventFixture()
+	created := time.Now().Add(-time.Hour)
+	updated := created.Add(time.Minute)
+	deleted := updated.Add(time.Minute)
+
+	if err := fixture.handle(t, accommodationUpdatedTopic, "Renamed", updated); err != nil {
+		t.Fatalf("handling the update failed: %v", err)
+	}
+	if err := fixture.handle(t, accommodationCreatedTopic, "Original", created); err != nil {
+		t.Fatalf("handling the late creation failed: %v", err)
+	}
+	if name := fixture.getName(t); name != "Renamed" {
+		t.Fatalf("accommodation is named %q after a late creation, want %q", name, "Renamed")
+	}
+
+	if err := fixture.handle(t, accommodationDeletedTopic, "", deleted); err != nil {
+		t.Fatalf("handling the deletion failed: %v", err)
+	}
+	if err := fixture.handle(t, accommodationUpdatedTopic, "Renamed again", deleted.Add(-time.Second)); err != nil {
+		t.Fatalf("handling the late update failed: %v", err)
+	}
+	if name := fixture.getName(t); name != "" {
+		t.Fatalf("late update recreated the deleted accommodation as %q", name)
+	}
+}
+
+func TestAccommodationDeletionKeepsUpcomingStays(t *testing.T) {
+	accommodationId := primitive.NewObjectID()
+	checkIn := time.Now().AddDate(0, 0, 10)
+	fixture := newAccommodationEventFixture(&domain.ReservationRequest{
+		Id:              primitive.NewObjectID(),
+		AccommodationId: accommodationId,
+		HostId:          "host",
+		UserId:          "guest",
+		Start:           checkIn,
+		End:             checkIn.AddDate(0, 0, 3),
+		Status:          domain.Approved,
+	})
+	fixture.accommodationId = accommodationId
+
+	if err := fixture.handle(t, accommodationCreatedTopic, "Cabin", time.Now().Add(-time.Hour)); err != nil {
+		t.Fatalf("handling the creation failed: %v", err)
+	}
+	err := fixture.handle(t, accommodationDeletedTopic, "", time.Now())
+	var validationError *domain.ValidationError
+	if !errors.As(err, &validationError) {
+		t.Fatalf("deleting an accommodation with an upcoming stay returned %v, want a validation error", err)
+	}
+	if name := fixture.getName(t); name != "Cabin" {
+		t.Fatalf("accommodation is named %q after a rejected deletion, want %q", name, "Cabin")
+	}
+	if tombstone, _ := fixture.tombstones.Get(accommodationId); tombstone != nil {
+		t.Fatalf("rejected deletion left a tombstone")
+	}
+}
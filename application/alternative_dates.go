@@ -0,0 +1,58 @@
+package application
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/util"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+const alternativeSearchDays = 365
+
+func (service *UnavailabilityService) SuggestAlternatives(ids []primitive.ObjectID, startDate time.Time, endDate time.Time, span trace.Span) ([]domain.AvailabilityAlternatives, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	util.HttpTraceInfo("Fetching unavailability by accommodation ids...", span, service.loki, "SuggestAlternatives", "")
+	unavailabilityList, err := service.store.GetByAccommodationIds(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	stay := stayFromInput(startDate, endDate)
+	if stay.Nights() <= 0 {
+		return nil, &domain.ValidationError{Message: "check-out must be after check-in"}
+	}
+	now := time.Now()
+	var alternatives []domain.AvailabilityAlternatives
+	for _, unavailability := range unavailabilityList {
+		conflictingPeriods := findStayConflicts(unavailability, stay)
+		if len(conflictingPeriods) == 0 {
+			continue
+		}
+		earliestCheckIn := today(unavailability, now)
+		alternatives = append(alternatives, domain.AvailabilityAlternatives{
+			AccommodationId:    unavailability.AccommodationId,
+			ConflictingPeriods: conflictingPeriods,
+			Earlier:            findNearestFreeWindow(unavailability, stay, -1, earliestCheckIn),
+			Later:              findNearestFreeWindow(unavailability, stay, 1, earliestCheckIn),
+		})
+	}
+	return alternatives, nil
+}
+
+// Shifts the requested stay one day at a time in the given direction until it is free and allowed by the stay rules.
+func findNearestFreeWindow(unavailability *domain.Unavailability, stay domain.Stay, direction int, earliestCheckIn domain.Date) *domain.DateRange {
+	for days := 1; days <= alternativeSearchDays; days++ {
+		shifted := domain.Stay{CheckIn: stay.CheckIn.AddDays(days * direction), CheckOut: stay.CheckOut.AddDays(days * direction)}
+		if shifted.CheckIn.Before(earliestCheckIn) {
+			return nil
+		}
+		if len(findStayConflicts(unavailability, shifted)) == 0 && checkStayRules(unavailability, shifted) == nil {
+			start, end := getStayBounds(unavailability, shifted)
+			return &domain.DateRange{Start: start, End: end}
+		}
+	}
+	return nil
+}
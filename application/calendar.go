@@ -0,0 +1,85 @@
+package application
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/util"
+	"github.com/afiskon/promtail-client/promtail"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+const maxCalendarNights = 366
+
+func (service *UnavailabilityService) GetCalendar(accommodationId primitive.ObjectID, from time.Time, to time.Time, span trace.Span, loki promtail.Client) ([]domain.CalendarDay, error) {
+	firstNight, lastNight := inputDate(from), inputDate(to)
+	if !firstNight.Before(lastNight) {
+		return nil, &domain.ValidationError{Message: "calendar start must be before its end"}
+	}
+	if firstNight.DaysUntil(lastNight) > maxCalendarNights {
+		return nil, &domain.ValidationError{Message: "calendar cannot span more than a year"}
+	}
+
+	util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "GetCalendar", "")
+	unavailability, err := service.store.GetByAccommodationId(accommodationId)
+	if err != nil {
+		return nil, err
+	}
+	if unavailability == nil {
+		return nil, domain.ErrAccommodationNotFound
+	}
+
+	util.HttpTraceInfo("Fetching pending reservation requests...", span, loki, "GetCalendar", "")
+	pendingRequests, err := service.reservationRequestStore.GetByAccommodationIdAndType(accommodationId, domain.Pending)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildCalendar(unavailability, firstNight, lastNight, pendingRequests), nil
+}
+
+// Nights are the dates in the accommodation's timezone; a period occupies the nights from its check-in date up to,
+// but not including, its check-out date.
+func buildCalendar(unavailability *domain.Unavailability, from domain.Date, to domain.Date, pendingRequests []*domain.ReservationRequest) []domain.CalendarDay {
+	unavailability = withOccurrences(unavailability, from, to)
+	var calendar []domain.CalendarDay
+	for night := from; night.Before(to); night = night.AddDays(1) {
+		day := domain.CalendarDay{Date: night}
+
+		for _, period := range unavailability.UnavailabilityPeriods {
+			stay := storedStay(unavailability, period.Start, period.End)
+			if period.Reason == domain.Reserved {
+				day.CheckIn = day.CheckIn || stay.CheckIn == night
+				day.CheckOut = day.CheckOut || stay.CheckOut == night
+			}
+			if !stay.Contains(night) || day.State == domain.ReservedDay {
+				continue
+			}
+			if period.Reason == domain.Reserved {
+				day.State = domain.ReservedDay
+				day.PeriodId = period.Id
+				day.ReservationId = period.ReservationId
+			} else if period.Reason == domain.External && day.State == domain.AvailableDay {
+				day.State = domain.ExternalBlockedDay
+				day.PeriodId = period.Id
+			} else if day.State == domain.AvailableDay {
+				day.State = domain.OwnerBlockedDay
+				day.PeriodId = period.Id
+				day.RecurrenceId = period.RecurrenceId
+			}
+		}
+
+		if day.State == domain.AvailableDay {
+			for _, request := range pendingRequests {
+				if storedStay(unavailability, request.Start, request.End).Contains(night) {
+					day.State = domain.PendingRequestedDay
+					day.ReservationId = request.Id
+					break
+				}
+			}
+		}
+
+		calendar = append(calendar, day)
+	}
+	return calendar
+}
@@ -0,0 +1,59 @@
+package application
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+)
+
+var cancellationPolicyTiers = map[domain.CancellationPolicyType][]domain.RefundTier{
+	domain.Flexible: {
+		{DaysBeforeStart: 1, RefundPercentage: 100},
+	},
+	domain.Moderate: {
+		{DaysBeforeStart: 5, RefundPercentage: 100},
+		{DaysBeforeStart: 1, RefundPercentage: 50},
+	},
+	domain.Strict: {
+		{DaysBeforeStart: 14, RefundPercentage: 100},
+		{DaysBeforeStart: 7, RefundPercentage: 50},
+	},
+	domain.NonRefundable: {},
+}
+
+func validateCancellationPolicy(policy domain.CancellationPolicy) error {
+	if policy.Type != domain.CustomCancellationPolicy {
+		if _, ok := cancellationPolicyTiers[policy.Type]; !ok {
+			return &domain.ValidationError{Message: "unknown cancellation policy"}
+		}
+		return nil
+	}
+	if len(policy.Tiers) == 0 {
+		return &domain.ValidationError{Message: "custom cancellation policy needs at least one refund tier"}
+	}
+	for _, tier := range policy.Tiers {
+		if tier.DaysBeforeStart < 0 {
+			return &domain.ValidationError{Message: "refund tier days before start cannot be negative"}
+		}
+		if tier.RefundPercentage < 0 || tier.RefundPercentage > 100 {
+			return &domain.ValidationError{Message: "refund percentage must be between 0 and 100"}
+		}
+	}
+	return nil
+}
+
+func getRefundTiers(policy domain.CancellationPolicy) []domain.RefundTier {
+	if policy.Type == domain.CustomCancellationPolicy {
+		return policy.Tiers
+	}
+	return cancellationPolicyTiers[policy.Type]
+}
+
+// The guest gets the best tier whose notice period they met, counted in whole days before the check-in date.
+func calculateRefund(policy domain.CancellationPolicy, priceTotal domain.Money, daysBeforeCheckIn int) domain.Money {
+	refundPercentage := 0
+	for _, tier := range getRefundTiers(policy) {
+		if daysBeforeCheckIn >= tier.DaysBeforeStart && tier.RefundPercentage > refundPercentage {
+			refundPercentage = tier.RefundPercentage
+		}
+	}
+	return priceTotal.Percentage(refundPercentage)
+}
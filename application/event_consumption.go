@@ -0,0 +1,50 @@
+package application
+
+import (
+	"context"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/util"
+	"github.com/afiskon/promtail-client/promtail"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+// startConsumerSpan continues the trace of the request that produced the event.
+func startConsumerSpan(tracer trace.Tracer, event domain.Event) trace.Span {
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(event.TraceContext))
+	_, span := tracer.Start(ctx, "consume-"+event.Topic, trace.WithSpanKind(trace.SpanKindConsumer))
+	return span
+}
+
+// consumeOnce applies an event once, however often it is delivered: the event is recorded as processed by consumer in
+// the same transaction that applies it.
+func consumeOnce(transactions domain.TransactionManager, processedEvents domain.ProcessedEventStore, consumer string, event domain.Event, span trace.Span, loki promtail.Client, apply func(transaction domain.Transaction) error) error {
+	return transactions.WithTransaction(func(transaction domain.Transaction) error {
+		store := processedEvents.WithContext(transaction.Context())
+		processed, err := store.Exists(consumer, event.Id)
+		if err != nil {
+			return err
+		}
+		if processed {
+			util.HttpTraceInfo("Skipping already processed event", span, loki, consumer, event.Id)
+			return nil
+		}
+		err = store.Insert(&domain.ProcessedEvent{
+			Consumer:    consumer,
+			EventId:     event.Id,
+			Topic:       event.Topic,
+			Partition:   event.Partition,
+			Offset:      event.Offset,
+			ProcessedAt: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		transaction.Compensate(func() error {
+			return processedEvents.Delete(consumer, event.Id)
+		})
+		return apply(transaction)
+	})
+}
@@ -0,0 +1,65 @@
+package application
+
+import (
+	"context"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/util"
+	"github.com/afiskon/promtail-client/promtail"
+	"go.opentelemetry.io/otel/trace"
+	"log"
+	"time"
+)
+
+const externalCalendarSyncLease = "external-calendar-sync"
+
+type ExternalCalendarSyncScheduler struct {
+	unavailabilityService *UnavailabilityService
+	leaseStore            domain.LeaseStore
+	interval              time.Duration
+	instanceId            string
+	tracer                trace.Tracer
+	loki                  promtail.Client
+}
+
+func NewExternalCalendarSyncScheduler(unavailabilityService *UnavailabilityService, leaseStore domain.LeaseStore, interval time.Duration, instanceId string, tracer trace.Tracer, loki promtail.Client) *ExternalCalendarSyncScheduler {
+	return &ExternalCalendarSyncScheduler{
+		unavailabilityService: unavailabilityService,
+		leaseStore:            leaseStore,
+		interval:              interval,
+		instanceId:            instanceId,
+		tracer:                tracer,
+		loki:                  loki,
+	}
+}
+
+func (scheduler *ExternalCalendarSyncScheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(scheduler.interval)
+	defer ticker.Stop()
+
+	for {
+		scheduler.run(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (scheduler *ExternalCalendarSyncScheduler) run(ctx context.Context) {
+	acquired, err := scheduler.leaseStore.Acquire(externalCalendarSyncLease, scheduler.instanceId, scheduler.interval)
+	if err != nil {
+		log.Printf("failed to acquire %s lease: %v", externalCalendarSyncLease, err)
+		return
+	}
+	if !acquired {
+		return
+	}
+
+	_, span := scheduler.tracer.Start(ctx, "external-calendar-sync-job")
+	defer func() { span.End() }()
+
+	if err := scheduler.unavailabilityService.SyncExternalCalendars(span, scheduler.loki); err != nil {
+		util.HttpTraceError(err, "failed to sync external calendars", span, scheduler.loki, "ExternalCalendarSyncScheduler", "")
+	}
+}
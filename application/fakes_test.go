@@ -0,0 +1,424 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/trace"
+	"slices"
+	"sync"
+	"time"
+)
+
+// The fakes below implement only what the tests call; any other method panics through the embedded nil interface.
+
+var noSpan = trace.SpanFromContext(context.Background())
+
+type noopLoki struct{}
+
+func (noopLoki) Debugf(string, ...interface{}) {}
+func (noopLoki) Infof(string, ...interface{})  {}
+func (noopLoki) Warnf(string, ...interface{})  {}
+func (noopLoki) Errorf(string, ...interface{}) {}
+func (noopLoki) Shutdown()                     {}
+
+// fakeTransactionManager behaves like a standalone Mongo deployment: steps apply at once and compensations run on failure.
+type fakeTransactionManager struct{}
+
+type fakeTransaction struct {
+	compensations []func() error
+}
+
+func (fakeTransactionManager) WithTransaction(fn func(transaction domain.Transaction) error) error {
+	transaction := &fakeTransaction{}
+	err := fn(transaction)
+	if err != nil {
+		for i := len(transaction.compensations) - 1; i >= 0; i-- {
+			_ = transaction.compensations[i]()
+		}
+	}
+	return err
+}
+
+func (transaction *fakeTransaction) Context() context.Context {
+	return context.Background()
+}
+
+func (transaction *fakeTransaction) Compensate(undo func() error) {
+	transaction.compensations = append(transaction.compensations, undo)
+}
+
+// fakeUnavailabilityStore compares and swaps on Version like the Mongo store does.
+type fakeUnavailabilityStore struct {
+	domain.UnavailabilityStore
+	mutex            sync.Mutex
+	unavailabilities map[primitive.ObjectID]*domain.Unavailability
+}
+
+func newFakeUnavailabilityStore(unavailabilities ...*domain.Unavailability) *fakeUnavailabilityStore {
+	store := &fakeUnavailabilityStore{unavailabilities: make(map[primitive.ObjectID]*domain.Unavailability)}
+	for _, unavailability := range unavailabilities {
+		store.unavailabilities[unavailability.Id] = unavailability
+	}
+	return store
+}
+
+func (store *fakeUnavailabilityStore) WithContext(context.Context) domain.UnavailabilityStore {
+	return store
+}
+
+func (store *fakeUnavailabilityStore) GetByAccommodationId(accommodationId primitive.ObjectID) (*domain.Unavailability, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	for _, unavailability := range store.unavailabilities {
+		if unavailability.AccommodationId == accommodationId {
+			copied := *unavailability
+			copied.UnavailabilityPeriods = copyPeriods(unavailability.UnavailabilityPeriods)
+			copied.ExternalCalendars = slices.Clone(unavailability.ExternalCalendars)
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (store *fakeUnavailabilityStore) UpdateUnavailabilityPeriods(unavailabilityId primitive.ObjectID, version int64, periods []domain.UnavailabilityPeriod) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	unavailability, ok := store.unavailabilities[unavailabilityId]
+	if !ok || unavailability.Version != version {
+		return domain.ErrConcurrentModification
+	}
+	unavailability.UnavailabilityPeriods = copyPeriods(periods)
+	unavailability.Version++
+	return nil
+}
+
+func (store *fakeUnavailabilityStore) Update(id primitive.ObjectID, unavailability *domain.Unavailability) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	stored, ok := store.unavailabilities[id]
+	if !ok || stored.Version != unavailability.Version {
+		return domain.ErrConcurrentModification
+	}
+	updated := *unavailability
+	updated.UnavailabilityPeriods = copyPeriods(unavailability.UnavailabilityPeriods)
+	updated.ExternalCalendars = slices.Clone(unavailability.ExternalCalendars)
+	updated.RecurringBlocks = copyRecurringBlocks(unavailability.RecurringBlocks)
+	updated.Version++
+	store.unavailabilities[id] = &updated
+	return nil
+}
+
+func (store *fakeUnavailabilityStore) GetByAccommodationIds(accommodationIds []primitive.ObjectID) ([]*domain.Unavailability, error) {
+	var unavailabilities []*domain.Unavailability
+	for _, accommodationId := range accommodationIds {
+		unavailability, _ := store.GetByAccommodationId(accommodationId)
+		if unavailability != nil {
+			unavailabilities = append(unavailabilities, unavailability)
+		}
+	}
+	return unavailabilities, nil
+}
+
+func (store *fakeUnavailabilityStore) UpsertAccommodation(unavailability *domain.Unavailability) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	for _, stored := range store.unavailabilities {
+		if stored.AccommodationId != unavailability.AccommodationId {
+			continue
+		}
+		if !stored.SourceUpdatedAt.Before(unavailability.SourceUpdatedAt) {
+			return domain.ErrConcurrentModification
+		}
+		stored.AccommodationName = unavailability.AccommodationName
+		stored.HostId = unavailability.HostId
+		stored.ReviewReservationRequestAutomatically = unavailability.ReviewReservationRequestAutomatically
+		stored.ReservationRequestResponseWindow = unavailability.ReservationRequestResponseWindow
+		stored.SourceUpdatedAt = unavailability.SourceUpdatedAt
+		stored.Version++
+		return nil
+	}
+	inserted := *unavailability
+	inserted.Id = primitive.NewObjectID()
+	inserted.Version = 1
+	store.unavailabilities[inserted.Id] = &inserted
+	return nil
+}
+
+func (store *fakeUnavailabilityStore) DeleteByAccommodationId(accommodationId primitive.ObjectID) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	for id, unavailability := range store.unavailabilities {
+		if unavailability.AccommodationId == accommodationId {
+			delete(store.unavailabilities, id)
+		}
+	}
+	return nil
+}
+
+type fakeAccommodationTombstoneStore struct {
+	mutex      sync.Mutex
+	tombstones map[primitive.ObjectID]domain.AccommodationTombstone
+}
+
+func (store *fakeAccommodationTombstoneStore) WithContext(context.Context) domain.AccommodationTombstoneStore {
+	return store
+}
+
+func (store *fakeAccommodationTombstoneStore) Get(accommodationId primitive.ObjectID) (*domain.AccommodationTombstone, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	tombstone, ok := store.tombstones[accommodationId]
+	if !ok {
+		return nil, nil
+	}
+	return &tombstone, nil
+}
+
+func (store *fakeAccommodationTombstoneStore) Upsert(tombstone *domain.AccommodationTombstone) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if store.tombstones == nil {
+		store.tombstones = make(map[primitive.ObjectID]domain.AccommodationTombstone)
+	}
+	if stored, ok := store.tombstones[tombstone.AccommodationId]; !ok || stored.DeletedAt.Before(tombstone.DeletedAt) {
+		store.tombstones[tombstone.AccommodationId] = *tombstone
+	}
+	return nil
+}
+
+func (store *fakeAccommodationTombstoneStore) Delete(accommodationId primitive.ObjectID) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.tombstones, accommodationId)
+	return nil
+}
+
+type fakeProcessedEventStore struct {
+	mutex  sync.Mutex
+	events map[string]bool
+}
+
+func (store *fakeProcessedEventStore) WithContext(context.Context) domain.ProcessedEventStore {
+	return store
+}
+
+func (store *fakeProcessedEventStore) Exists(consumer string, eventId string) (bool, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	return store.events[consumer+"/"+eventId], nil
+}
+
+func (store *fakeProcessedEventStore) Insert(event *domain.ProcessedEvent) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if store.events == nil {
+		store.events = make(map[string]bool)
+	}
+	store.events[event.Consumer+"/"+event.EventId] = true
+	return nil
+}
+
+func (store *fakeProcessedEventStore) Delete(consumer string, eventId string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.events, consumer+"/"+eventId)
+	return nil
+}
+
+type fakeReservationRequestStore struct {
+	domain.ReservationRequestStore
+	mutex    sync.Mutex
+	requests map[primitive.ObjectID]*domain.ReservationRequest
+}
+
+func newFakeReservationRequestStore(requests ...*domain.ReservationRequest) *fakeReservationRequestStore {
+	store := &fakeReservationRequestStore{requests: make(map[primitive.ObjectID]*domain.ReservationRequest)}
+	for _, request := range requests {
+		store.requests[request.Id] = request
+	}
+	return store
+}
+
+func (store *fakeReservationRequestStore) WithContext(context.Context) domain.ReservationRequestStore {
+	return store
+}
+
+func (store *fakeReservationRequestStore) Get(id primitive.ObjectID) (*domain.ReservationRequest, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	request, ok := store.requests[id]
+	if !ok {
+		return nil, errors.New("reservation request not found")
+	}
+	copied := *request
+	return &copied, nil
+}
+
+func (store *fakeReservationRequestStore) GetByAccommodationIdAndType(accommodationId primitive.ObjectID, status domain.ReservationRequestStatus) ([]*domain.ReservationRequest, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	var requests []*domain.ReservationRequest
+	for _, request := range store.requests {
+		if request.AccommodationId == accommodationId && request.Status == status {
+			copied := *request
+			requests = append(requests, &copied)
+		}
+	}
+	return requests, nil
+}
+
+func (store *fakeReservationRequestStore) GetByClientIdAndStatus(clientId string, status domain.ReservationRequestStatus) ([]*domain.ReservationRequest, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	var requests []*domain.ReservationRequest
+	for _, request := range store.requests {
+		if request.UserId == clientId && request.Status == status {
+			copied := *request
+			requests = append(requests, &copied)
+		}
+	}
+	return requests, nil
+}
+
+func (store *fakeReservationRequestStore) AnonymizeClient(clientId string, tombstoneId string, anonymizedAt time.Time) (int64, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	var anonymized int64
+	for _, request := range store.requests {
+		if request.UserId == clientId {
+			request.UserId = tombstoneId
+			request.AnonymizedAt = anonymizedAt
+			anonymized++
+		}
+	}
+	return anonymized, nil
+}
+
+func (store *fakeReservationRequestStore) UpdateStatus(id primitive.ObjectID, current domain.ReservationRequestStatus, next domain.ReservationRequestStatus) (bool, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	request, ok := store.requests[id]
+	if !ok || request.Status != current {
+		return false, nil
+	}
+	request.Status = next
+	return true, nil
+}
+
+func (store *fakeReservationRequestStore) CancelOverlappingPendingRequests(reservationRequest *domain.ReservationRequest, start time.Time, end time.Time) ([]primitive.ObjectID, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	var ids []primitive.ObjectID
+	for _, request := range store.requests {
+		if request.Id == reservationRequest.Id || request.AccommodationId != reservationRequest.AccommodationId || request.Status != domain.Pending {
+			continue
+		}
+		if request.Start.Before(end) && request.End.After(start) {
+			request.Status = domain.DeclinedByHost
+			ids = append(ids, request.Id)
+		}
+	}
+	return ids, nil
+}
+
+func (store *fakeReservationRequestStore) RestorePendingRequests(ids []primitive.ObjectID) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	for _, id := range ids {
+		if request, ok := store.requests[id]; ok && request.Status == domain.DeclinedByHost {
+			request.Status = domain.Pending
+		}
+	}
+	return nil
+}
+
+type fakeOutboxStore struct {
+	domain.OutboxStore
+	mutex    sync.Mutex
+	messages []*domain.OutboxMessage
+}
+
+func (store *fakeOutboxStore) WithContext(context.Context) domain.OutboxStore {
+	return store
+}
+
+func (store *fakeOutboxStore) Insert(message *domain.OutboxMessage) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.messages = append(store.messages, message)
+	return nil
+}
+
+func (store *fakeOutboxStore) Delete(id primitive.ObjectID) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	for i, message := range store.messages {
+		if message.Id == id {
+			store.messages = append(store.messages[:i], store.messages[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (store *fakeOutboxStore) GetDue(now time.Time, limit int) ([]*domain.OutboxMessage, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	var messages []*domain.OutboxMessage
+	for _, message := range store.messages {
+		if message.DeliveredAt == nil && !message.NextAttemptAt.After(now) && len(messages) < limit {
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil
+}
+
+func (store *fakeOutboxStore) MarkDelivered(id primitive.ObjectID, deliveredAt time.Time) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	for _, message := range store.messages {
+		if message.Id == id {
+			message.DeliveredAt = &deliveredAt
+			message.Attempts++
+		}
+	}
+	return nil
+}
+
+func (store *fakeOutboxStore) MarkFailed(id primitive.ObjectID, nextAttemptAt time.Time, lastError string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	for _, message := range store.messages {
+		if message.Id == id {
+			message.NextAttemptAt = nextAttemptAt
+			message.LastError = lastError
+			message.Attempts++
+		}
+	}
+	return nil
+}
+
+type fakeLeaseStore struct{}
+
+func (fakeLeaseStore) Acquire(string, string, time.Duration) (bool, error) {
+	return true, nil
+}
+
+// fakeEventPublisher records the events it is given and refuses those whose id is marked failing.
+type fakeEventPublisher struct {
+	mutex   sync.Mutex
+	events  []domain.Event
+	failing map[string]bool
+}
+
+func (publisher *fakeEventPublisher) Publish(event domain.Event) error {
+	publisher.mutex.Lock()
+	defer publisher.mutex.Unlock()
+	if publisher.failing[event.Id] {
+		return errors.New("broker unavailable")
+	}
+	publisher.events = append(publisher.events, event)
+	return nil
+}
@@ -0,0 +1,100 @@
+package application
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/util"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+func (service *UnavailabilityService) FindFlexibleAvailability(ids []primitive.ObjectID, windowStart time.Time, windowEnd time.Time, minNights int, maxNights int, span trace.Span) ([]domain.FlexibleAvailability, error) {
+	firstNight, lastNight := inputDate(windowStart), inputDate(windowEnd)
+	if maxNights == 0 {
+		maxNights = minNights
+	}
+	if minNights <= 0 || maxNights < minNights {
+		return nil, &domain.ValidationError{Message: "stay length must be positive and min nights cannot exceed max nights"}
+	}
+	if !firstNight.Before(lastNight) {
+		return nil, &domain.ValidationError{Message: "search window start must be before its end"}
+	}
+	if firstNight.DaysUntil(lastNight) > maxCalendarNights {
+		return nil, &domain.ValidationError{Message: "search window cannot span more than a year"}
+	}
+
+	util.HttpTraceInfo("Fetching unavailability by accommodation ids...", span, service.loki, "FindFlexibleAvailability", "")
+	unavailabilityList, err := service.store.GetByAccommodationIds(ids)
+	if err != nil {
+		return nil, err
+	}
+	unavailabilityByAccommodation := make(map[primitive.ObjectID]*domain.Unavailability, len(unavailabilityList))
+	for _, unavailability := range unavailabilityList {
+		unavailabilityByAccommodation[unavailability.AccommodationId] = unavailability
+	}
+
+	var response []domain.FlexibleAvailability
+	for _, id := range ids {
+		unavailability := unavailabilityByAccommodation[id]
+		if unavailability == nil {
+			unavailability = &domain.Unavailability{AccommodationId: id}
+		}
+		response = append(response, domain.FlexibleAvailability{
+			AccommodationId: id,
+			Stays:           findFlexibleStays(unavailability, firstNight, lastNight, minNights, maxNights),
+		})
+	}
+	return response, nil
+}
+
+// Every night of the window is marked free or taken once, then each check-in date is extended over the following free
+// nights and shortened again until the stay clears the turnover buffers.
+func findFlexibleStays(unavailability *domain.Unavailability, firstNight domain.Date, lastNight domain.Date, minNights int, maxNights int) []domain.FlexibleStay {
+	unavailability = withOccurrences(unavailability, firstNight, lastNight)
+	var nights []domain.Date
+	for night := firstNight; night.Before(lastNight); night = night.AddDays(1) {
+		nights = append(nights, night)
+	}
+
+	// freeNightsFrom[i] counts the consecutive free nights starting at nights[i].
+	freeNightsFrom := make([]int, len(nights)+1)
+	for i := len(nights) - 1; i >= 0; i-- {
+		if isNightFree(unavailability, nights[i]) {
+			freeNightsFrom[i] = freeNightsFrom[i+1] + 1
+		}
+	}
+
+	var stays []domain.FlexibleStay
+	for i, night := range nights {
+		rule := getStayRule(unavailability, night)
+		if !isCheckInDayAllowed(rule, night) {
+			continue
+		}
+		shortestStay := max(minNights, rule.MinNights)
+		longestStay := maxNights
+		if rule.MaxNights > 0 {
+			longestStay = min(longestStay, rule.MaxNights)
+		}
+		longestFreeStay := min(freeNightsFrom[i], longestStay)
+		for longestFreeStay >= shortestStay && len(findStayConflicts(unavailability, domain.Stay{CheckIn: night, CheckOut: night.AddDays(longestFreeStay)})) > 0 {
+			longestFreeStay--
+		}
+		if longestFreeStay < shortestStay {
+			continue
+		}
+		stays = append(stays, domain.FlexibleStay{
+			CheckIn:   night,
+			MaxNights: longestFreeStay,
+		})
+	}
+	return stays
+}
+
+func isNightFree(unavailability *domain.Unavailability, night domain.Date) bool {
+	for _, period := range unavailability.UnavailabilityPeriods {
+		if storedStay(unavailability, period.Start, period.End).Contains(night) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,126 @@
+package application
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/util"
+	"github.com/afiskon/promtail-client/promtail"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	icalTokenBytes  = 32
+	icalUIDDomain   = "@" + domain.ServiceName
+	reservedSummary = "Reserved"
+	ownerSetSummary = "Not available"
+)
+
+// RotateICalToken replaces the accommodation's export token, so feeds subscribed with the old one stop working.
+func (service *UnavailabilityService) RotateICalToken(accommodationId primitive.ObjectID, span trace.Span, loki promtail.Client) (string, error) {
+	token, err := generateICalToken()
+	if err != nil {
+		return "", err
+	}
+
+	err = retryOnConcurrentModification(func() error {
+		util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "RotateICalToken", "")
+		unavailability, err := service.store.GetByAccommodationId(accommodationId)
+		if err != nil {
+			return err
+		}
+		if unavailability == nil {
+			return domain.ErrAccommodationNotFound
+		}
+
+		unavailability.ICalExportToken = token
+
+		util.HttpTraceInfo("Updating calendar export token...", span, loki, "RotateICalToken", "")
+		return service.store.Update(unavailability.Id, unavailability)
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// GetICalEvents returns the accommodation's name and its reserved and owner-set periods as calendar events. An
+// accommodation without a token has no feed.
+func (service *UnavailabilityService) GetICalEvents(accommodationId primitive.ObjectID, token string, span trace.Span, loki promtail.Client) (string, []domain.CalendarEvent, error) {
+	util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "GetICalEvents", "")
+	unavailability, err := service.store.GetByAccommodationId(accommodationId)
+	if err != nil {
+		return "", nil, err
+	}
+	if unavailability == nil {
+		return "", nil, domain.ErrAccommodationNotFound
+	}
+	if unavailability.ICalExportToken == "" || subtle.ConstantTimeCompare([]byte(unavailability.ICalExportToken), []byte(token)) != 1 {
+		return "", nil, domain.ErrInvalidICalToken
+	}
+
+	return unavailability.AccommodationName, buildICalEvents(unavailability), nil
+}
+
+// UIDs are derived from period and block ids, which survive edits, so subscribers update events instead of duplicating them.
+func buildICalEvents(unavailability *domain.Unavailability) []domain.CalendarEvent {
+	var events []domain.CalendarEvent
+	for _, period := range unavailability.UnavailabilityPeriods {
+		summary := ownerSetSummary
+		if period.Reason == domain.Reserved {
+			summary = reservedSummary
+		}
+		events = append(events, domain.CalendarEvent{
+			UID:     period.Id.Hex() + icalUIDDomain,
+			Summary: summary,
+			Stay:    storedStay(unavailability, period.Start, period.End),
+		})
+	}
+
+	for _, block := range unavailability.RecurringBlocks {
+		// DTSTART always counts as an occurrence in iCalendar, so the series starts at the first date the rule yields.
+		firstOccurrence, ok := getFirstOccurrence(block)
+		if !ok {
+			continue
+		}
+		rule := block.Rule
+		uid := block.Id.Hex() + icalUIDDomain
+		events = append(events, domain.CalendarEvent{
+			UID:        uid,
+			Summary:    ownerSetSummary,
+			Stay:       domain.Stay{CheckIn: firstOccurrence, CheckOut: firstOccurrence.AddDays(block.Nights)},
+			Rule:       &rule,
+			Exceptions: block.Exceptions,
+		})
+		for _, modified := range block.ModifiedOccurrences {
+			recurrenceId := modified.RecurrenceId
+			events = append(events, domain.CalendarEvent{
+				UID:          uid,
+				Summary:      ownerSetSummary,
+				Stay:         modified.Stay,
+				RecurrenceId: &recurrenceId,
+			})
+		}
+	}
+	return events
+}
+
+func getFirstOccurrence(block domain.RecurringBlock) (domain.Date, bool) {
+	var first domain.Date
+	found := false
+	forEachRecurrenceDate(block, block.Start.AddDays(maxRecurrenceSearchDays), func(date domain.Date) bool {
+		first, found = date, true
+		return false
+	})
+	return first, found
+}
+
+func generateICalToken() (string, error) {
+	token := make([]byte, icalTokenBytes)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
@@ -0,0 +1,313 @@
+package application
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/util"
+	"github.com/afiskon/promtail-client/promtail"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/trace"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const externalCalendarHorizonDays = 2 * 366
+
+var externalCalendarSchemes = []string{"http", "https", "webcal"}
+
+func (service *UnavailabilityService) GetExternalCalendars(accommodationId primitive.ObjectID, span trace.Span, loki promtail.Client) ([]domain.ExternalCalendar, error) {
+	util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "GetExternalCalendars", "")
+	unavailability, err := service.store.GetByAccommodationId(accommodationId)
+	if err != nil {
+		return nil, err
+	}
+	if unavailability == nil {
+		return nil, domain.ErrAccommodationNotFound
+	}
+	return unavailability.ExternalCalendars, nil
+}
+
+func (service *UnavailabilityService) AddExternalCalendar(accommodationId primitive.ObjectID, calendar *domain.ExternalCalendar, span trace.Span, loki promtail.Client) error {
+	if err := validateExternalCalendarUrl(calendar.Url); err != nil {
+		return err
+	}
+
+	calendar.Id = primitive.NewObjectID()
+	return service.updateExternalCalendars(accommodationId, "AddExternalCalendar", span, loki, func(unavailability *domain.Unavailability) error {
+		unavailability.ExternalCalendars = append(unavailability.ExternalCalendars, *calendar)
+		return nil
+	})
+}
+
+// RemoveExternalCalendar stops syncing a feed and releases every date it blocked.
+func (service *UnavailabilityService) RemoveExternalCalendar(accommodationId primitive.ObjectID, calendarId primitive.ObjectID, span trace.Span, loki promtail.Client) error {
+	return service.updateExternalCalendars(accommodationId, "RemoveExternalCalendar", span, loki, func(unavailability *domain.Unavailability) error {
+		index, err := findExternalCalendar(unavailability, calendarId)
+		if err != nil {
+			return err
+		}
+		unavailability.ExternalCalendars = slices.Delete(unavailability.ExternalCalendars, index, index+1)
+		unavailability.UnavailabilityPeriods = slices.DeleteFunc(unavailability.UnavailabilityPeriods, func(period domain.UnavailabilityPeriod) bool {
+			return period.Reason == domain.External && period.ExternalCalendarId == calendarId
+		})
+		return nil
+	})
+}
+
+// SyncExternalCalendar fetches a feed right away. A feed that cannot be fetched or read leaves its blocks as they
+// were and reports the failure in LastSyncError.
+func (service *UnavailabilityService) SyncExternalCalendar(accommodationId primitive.ObjectID, calendarId primitive.ObjectID, span trace.Span, loki promtail.Client) (*domain.ExternalCalendar, error) {
+	util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "SyncExternalCalendar", "")
+	unavailability, err := service.store.GetByAccommodationId(accommodationId)
+	if err != nil {
+		return nil, err
+	}
+	if unavailability == nil {
+		return nil, domain.ErrAccommodationNotFound
+	}
+	index, err := findExternalCalendar(unavailability, calendarId)
+	if err != nil {
+		return nil, err
+	}
+	return service.syncExternalCalendar(accommodationId, unavailability.ExternalCalendars[index], span, loki)
+}
+
+func (service *UnavailabilityService) SyncExternalCalendars(span trace.Span, loki promtail.Client) error {
+	util.HttpTraceInfo("Fetching unavailabilities with external calendars...", span, loki, "SyncExternalCalendars", "")
+	unavailabilities, err := service.store.GetWithExternalCalendars()
+	if err != nil {
+		return err
+	}
+
+	for _, unavailability := range unavailabilities {
+		for _, calendar := range unavailability.ExternalCalendars {
+			synced, err := service.syncExternalCalendar(unavailability.AccommodationId, calendar, span, loki)
+			if err != nil {
+				util.HttpTraceError(err, "failed to save external calendar "+calendar.Id.Hex(), span, loki, "SyncExternalCalendars", "")
+				continue
+			}
+			if synced.LastSyncError != "" {
+				util.HttpTraceInfo("External calendar "+calendar.Id.Hex()+" failed to sync: "+synced.LastSyncError, span, loki, "SyncExternalCalendars", "")
+			}
+			if len(synced.Conflicts) > 0 {
+				util.HttpTraceInfo("External calendar "+calendar.Id.Hex()+" overlaps reservations", span, loki, "SyncExternalCalendars", "")
+			}
+		}
+	}
+	return nil
+}
+
+// The feed is fetched once, outside the retry, so a concurrent edit only repeats the reconciliation.
+func (service *UnavailabilityService) syncExternalCalendar(accommodationId primitive.ObjectID, calendar domain.ExternalCalendar, span trace.Span, loki promtail.Client) (*domain.ExternalCalendar, error) {
+	util.HttpTraceInfo("Fetching external calendar "+calendar.Id.Hex()+"...", span, loki, "SyncExternalCalendar", "")
+	feed, fetchErr := service.calendarFetcher.Fetch(calendar.Url, calendar.ETag)
+
+	var synced domain.ExternalCalendar
+	err := service.updateExternalCalendars(accommodationId, "SyncExternalCalendar", span, loki, func(unavailability *domain.Unavailability) error {
+		index, err := findExternalCalendar(unavailability, calendar.Id)
+		if err != nil {
+			return err
+		}
+		current := &unavailability.ExternalCalendars[index]
+		now := time.Now()
+		// An unchanged feed keeps the blocks imported from it; only reservations made since can add conflicts.
+		if fetchErr == nil && feed.NotModified {
+			current.Conflicts = findExternalCalendarConflicts(unavailability, current.Id, today(unavailability, now))
+		} else if fetchErr == nil {
+			fetchErr = reconcileExternalEvents(unavailability, current, feed.Events, now)
+		}
+		if fetchErr != nil {
+			current.LastSyncError = fetchErr.Error()
+		} else {
+			current.LastSyncedAt = now
+			current.LastSyncError = ""
+			current.ETag = feed.ETag
+		}
+		synced = *current
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &synced, nil
+}
+
+func (service *UnavailabilityService) updateExternalCalendars(accommodationId primitive.ObjectID, function string, span trace.Span, loki promtail.Client, update func(unavailability *domain.Unavailability) error) error {
+	return retryOnConcurrentModification(func() error {
+		util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, function, "")
+		unavailability, err := service.store.GetByAccommodationId(accommodationId)
+		if err != nil {
+			return err
+		}
+		if unavailability == nil {
+			return domain.ErrAccommodationNotFound
+		}
+
+		if err := update(unavailability); err != nil {
+			return err
+		}
+
+		util.HttpTraceInfo("Updating external calendars...", span, loki, function, "")
+		return service.store.Update(unavailability.Id, unavailability)
+	})
+}
+
+func findExternalCalendar(unavailability *domain.Unavailability, calendarId primitive.ObjectID) (int, error) {
+	for i, calendar := range unavailability.ExternalCalendars {
+		if calendar.Id == calendarId {
+			return i, nil
+		}
+	}
+	return -1, domain.ErrExternalCalendarNotFound
+}
+
+func validateExternalCalendarUrl(value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil || !slices.Contains(externalCalendarSchemes, strings.ToLower(parsed.Scheme)) || parsed.Host == "" {
+		return &domain.ValidationError{Message: "external calendar url must be an http, https or webcal address"}
+	}
+	return nil
+}
+
+// reconcileExternalEvents makes the feed's upcoming events the only External periods of the calendar, matching them
+// to existing periods by UID so moved events keep their period id. Periods that have already ended are kept, since
+// most channels drop past events from their feeds.
+func reconcileExternalEvents(unavailability *domain.Unavailability, calendar *domain.ExternalCalendar, events []domain.CalendarEvent, now time.Time) error {
+	from := today(unavailability, now)
+	stays, err := expandExternalEvents(events, from, from.AddDays(externalCalendarHorizonDays))
+	if err != nil {
+		return err
+	}
+
+	var periods []domain.UnavailabilityPeriod
+	for _, period := range unavailability.UnavailabilityPeriods {
+		if period.Reason != domain.External || period.ExternalCalendarId != calendar.Id {
+			periods = append(periods, period)
+			continue
+		}
+		if stay, ok := stays[period.ExternalUID]; ok {
+			period.Start, period.End = getStayBounds(unavailability, stay)
+			periods = append(periods, period)
+			delete(stays, period.ExternalUID)
+		} else if !storedStay(unavailability, period.Start, period.End).CheckOut.After(from) {
+			periods = append(periods, period)
+		}
+	}
+
+	uids := make([]string, 0, len(stays))
+	for uid := range stays {
+		uids = append(uids, uid)
+	}
+	slices.Sort(uids)
+	for _, uid := range uids {
+		start, end := getStayBounds(unavailability, stays[uid])
+		periods = append(periods, domain.UnavailabilityPeriod{
+			Id:                 primitive.NewObjectID(),
+			Start:              start,
+			End:                end,
+			Reason:             domain.External,
+			ExternalCalendarId: calendar.Id,
+			ExternalUID:        uid,
+		})
+	}
+
+	sortPeriodsByStartTime(periods)
+	unavailability.UnavailabilityPeriods = periods
+	calendar.Conflicts = findExternalCalendarConflicts(unavailability, calendar.Id, from)
+	return nil
+}
+
+// expandExternalEvents returns the stays of the events overlapping [from, to), keyed by UID. Occurrences of a
+// recurring event are keyed by UID and recurrence date, so each can move or disappear on its own.
+func expandExternalEvents(events []domain.CalendarEvent, from domain.Date, to domain.Date) (map[string]domain.Stay, error) {
+	window := domain.Stay{CheckIn: from, CheckOut: to}
+	stays := make(map[string]domain.Stay)
+	series := make(map[string]*domain.RecurringBlock)
+	for _, event := range events {
+		if event.Rule == nil {
+			continue
+		}
+		block := &domain.RecurringBlock{
+			Start:      event.Stay.CheckIn,
+			Nights:     event.Stay.Nights(),
+			Rule:       *event.Rule,
+			Exceptions: event.Exceptions,
+		}
+		if err := validateRecurringBlock(*block); err != nil {
+			return nil, err
+		}
+		series[event.UID] = block
+	}
+
+	for _, event := range events {
+		if event.Rule != nil {
+			continue
+		}
+		if block, ok := series[event.UID]; ok && event.RecurrenceId != nil {
+			block.ModifiedOccurrences = append(block.ModifiedOccurrences, domain.ModifiedOccurrence{
+				RecurrenceId: *event.RecurrenceId,
+				Stay:         event.Stay,
+			})
+			continue
+		}
+		uid := event.UID
+		if event.RecurrenceId != nil {
+			uid = getOccurrenceUID(event.UID, *event.RecurrenceId)
+		}
+		if event.Stay.Overlaps(window) {
+			stays[uid] = event.Stay
+		}
+	}
+
+	for uid, block := range series {
+		addStartOccurrence(block)
+		for _, occurrence := range expandRecurringBlock(*block, from, to) {
+			stays[getOccurrenceUID(uid, occurrence.recurrenceId)] = occurrence.stay
+		}
+	}
+	return stays, nil
+}
+
+// DTSTART is always the first instance of an iCalendar series, even when the rule would not yield it.
+func addStartOccurrence(block *domain.RecurringBlock) {
+	if isOccurrenceDate(*block, block.Start) || slices.Contains(block.Exceptions, block.Start) {
+		return
+	}
+	for _, modified := range block.ModifiedOccurrences {
+		if modified.RecurrenceId == block.Start {
+			return
+		}
+	}
+	block.ModifiedOccurrences = append(block.ModifiedOccurrences, domain.ModifiedOccurrence{
+		RecurrenceId: block.Start,
+		Stay:         domain.Stay{CheckIn: block.Start, CheckOut: block.Start.AddDays(block.Nights)},
+	})
+}
+
+func getOccurrenceUID(uid string, recurrenceId domain.Date) string {
+	return uid + "/" + recurrenceId.String()
+}
+
+// An imported event overlapping a reservation made here is a double booking the host has to resolve on one side.
+func findExternalCalendarConflicts(unavailability *domain.Unavailability, calendarId primitive.ObjectID, from domain.Date) []domain.ExternalCalendarConflict {
+	var conflicts []domain.ExternalCalendarConflict
+	for _, period := range unavailability.UnavailabilityPeriods {
+		if period.Reason != domain.External || period.ExternalCalendarId != calendarId {
+			continue
+		}
+		stay := storedStay(unavailability, period.Start, period.End)
+		if !stay.CheckOut.After(from) {
+			continue
+		}
+		for _, reserved := range getReservedPeriods(findConflictingPeriods(unavailability, period.Start, period.End)) {
+			conflicts = append(conflicts, domain.ExternalCalendarConflict{
+				UID:              period.ExternalUID,
+				Stay:             stay,
+				ReservedPeriodId: reserved.Id,
+				ReservationId:    reserved.ReservationId,
+			})
+		}
+	}
+	return conflicts
+}
@@ -0,0 +1,250 @@
+package application
+
+import (
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/ical"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Fixture feeds take their dates as days from today, so they stay within the import horizon.
+const (
+	validFeed = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Channel//EN
+BEGIN:VEVENT
+UID:first@channel
+DTSTART;VALUE=DATE:%[1]s
+DTEND;VALUE=DATE:%[2]s
+SUMMARY:Reserved
+END:VEVENT
+BEGIN:VEVENT
+UID:second@channel
+DTSTART;VALUE=DATE:%[3]s
+DTEND;VALUE=DATE:%[4]s
+SUMMARY:Reserved
+END:VEVENT
+END:VCALENDAR
+`
+	foldedFeed = "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:folded-event-with-a-uid-long-enough-to-be-folded-across-two-content-\r\n" +
+		" lines@channel\r\n" +
+		"DTSTART;TZID=Europe/Belgrade:%[1]sT150000\r\n" +
+		"DTEND;TZID=Europe/Belgrade:%[2]sT110000\r\n" +
+		"SUMMARY:Guest\\, two nights\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:all-day@channel\r\n" +
+		"DTSTART;VALUE=DATE:%[3]s\r\n" +
+		"SUMMARY:Not available\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+	removedFeed = `BEGIN:VCALENDAR
+VERSION:2.0
+BEGIN:VEVENT
+UID:first@channel
+DTSTART;VALUE=DATE:%[1]s
+DTEND;VALUE=DATE:%[2]s
+END:VEVENT
+END:VCALENDAR
+`
+	malformedFeed = `<html><body>Service unavailable</body></html>`
+)
+
+type feedServer struct {
+	*httptest.Server
+	mutex       sync.Mutex
+	body        string
+	etag        string
+	ifNoneMatch []string
+}
+
+func newFeedServer(t *testing.T) *feedServer {
+	server := &feedServer{}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		server.ifNoneMatch = append(server.ifNoneMatch, r.Header.Get("If-None-Match"))
+		if server.etag != "" && r.Header.Get("If-None-Match") == server.etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		if server.etag != "" {
+			w.Header().Set("ETag", server.etag)
+		}
+		w.Header().Set("Content-Type", "text/calendar")
+		fmt.Fprint(w, server.body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (server *feedServer) serve(body string, etag string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.body, server.etag = body, etag
+}
+
+type importFixture struct {
+	service         *UnavailabilityService
+	store           *fakeUnavailabilityStore
+	accommodationId primitive.ObjectID
+	calendarId      primitive.ObjectID
+	today           domain.Date
+}
+
+func newImportFixture(t *testing.T, url string) *importFixture {
+	fixture := &importFixture{
+		accommodationId: primitive.NewObjectID(),
+		calendarId:      primitive.NewObjectID(),
+		today:           domain.DateOf(time.Now(), time.UTC),
+	}
+	fixture.store = newFakeUnavailabilityStore(&domain.Unavailability{
+		Id:                    primitive.NewObjectID(),
+		AccommodationId:       fixture.accommodationId,
+		UnavailabilityPeriods: []domain.UnavailabilityPeriod{},
+		ExternalCalendars:     []domain.ExternalCalendar{{Id: fixture.calendarId, Name: "Channel", Url: url}},
+	})
+	fetcher := ical.NewHttpFeedFetcher(5*time.Second, true)
+	fixture.service = NewUnavailabilityService(fixture.store, fakeTransactionManager{}, &fakeOutboxStore{}, nil, fetcher, nil, noopLoki{})
+	return fixture
+}
+
+// day formats a date the given number of days from today as an iCalendar DATE.
+func (fixture *importFixture) day(days int) string {
+	return strings.ReplaceAll(fixture.today.AddDays(days).String(), "-", "")
+}
+
+func (fixture *importFixture) sync(t *testing.T) *domain.ExternalCalendar {
+	t.Helper()
+	calendar, err := fixture.service.SyncExternalCalendar(fixture.accommodationId, fixture.calendarId, noSpan, noopLoki{})
+	if err != nil {
+		t.Fatalf("sync failed: %v", err)
+	}
+	return calendar
+}
+
+// getPeriods returns the imported periods by UID.
+func (fixture *importFixture) getPeriods() map[string]domain.UnavailabilityPeriod {
+	unavailability, _ := fixture.store.GetByAccommodationId(fixture.accommodationId)
+	periods := make(map[string]domain.UnavailabilityPeriod)
+	for _, period := range unavailability.UnavailabilityPeriods {
+		if period.Reason == domain.External {
+			periods[period.ExternalUID] = period
+		}
+	}
+	return periods
+}
+
+func (fixture *importFixture) assertStay(t *testing.T, period domain.UnavailabilityPeriod, checkIn int, checkOut int) {
+	t.Helper()
+	want := domain.Stay{CheckIn: fixture.today.AddDays(checkIn), CheckOut: fixture.today.AddDays(checkOut)}
+	if got := storedStay(nil, period.Start, period.End); got != want {
+		t.Errorf("period %s covers %s to %s, want %s to %s", period.ExternalUID, got.CheckIn, got.CheckOut, want.CheckIn, want.CheckOut)
+	}
+}
+
+func TestSyncExternalCalendarImportsValidFeed(t *testing.T) {
+	server := newFeedServer(t)
+	fixture := newImportFixture(t, server.URL)
+	server.serve(fmt.Sprintf(validFeed, fixture.day(3), fixture.day(6), fixture.day(10), fixture.day(12)), "")
+
+	calendar := fixture.sync(t)
+	if calendar.LastSyncError != "" {
+		t.Fatalf("sync reported %q", calendar.LastSyncError)
+	}
+	periods := fixture.getPeriods()
+	if len(periods) != 2 {
+		t.Fatalf("imported %d periods, want 2", len(periods))
+	}
+	fixture.assertStay(t, periods["first@channel"], 3, 6)
+	fixture.assertStay(t, periods["second@channel"], 10, 12)
+}
+
+func TestSyncExternalCalendarReadsFoldedTimeZoneAndAllDayEvents(t *testing.T) {
+	server := newFeedServer(t)
+	fixture := newImportFixture(t, server.URL)
+	server.serve(fmt.Sprintf(foldedFeed, fixture.day(4), fixture.day(6), fixture.day(8)), "")
+
+	calendar := fixture.sync(t)
+	if calendar.LastSyncError != "" {
+		t.Fatalf("sync reported %q", calendar.LastSyncError)
+	}
+	periods := fixture.getPeriods()
+	folded, ok := periods["folded-event-with-a-uid-long-enough-to-be-folded-across-two-content-lines@channel"]
+	if !ok {
+		t.Fatalf("folded UID was not unfolded, imported %v", periods)
+	}
+	fixture.assertStay(t, folded, 4, 6)
+	fixture.assertStay(t, periods["all-day@channel"], 8, 9)
+}
+
+func TestSyncExternalCalendarKeepsBlocksOfUnmodifiedFeed(t *testing.T) {
+	server := newFeedServer(t)
+	fixture := newImportFixture(t, server.URL)
+	server.serve(fmt.Sprintf(validFeed, fixture.day(3), fixture.day(6), fixture.day(10), fixture.day(12)), `"v1"`)
+
+	if calendar := fixture.sync(t); calendar.ETag != `"v1"` {
+		t.Fatalf("stored ETag %q, want %q", calendar.ETag, `"v1"`)
+	}
+	before := fixture.getPeriods()
+
+	calendar := fixture.sync(t)
+	if calendar.LastSyncError != "" {
+		t.Fatalf("sync reported %q", calendar.LastSyncError)
+	}
+	if got := server.ifNoneMatch[len(server.ifNoneMatch)-1]; got != `"v1"` {
+		t.Errorf("second fetch sent If-None-Match %q, want %q", got, `"v1"`)
+	}
+	after := fixture.getPeriods()
+	if len(after) != len(before) {
+		t.Fatalf("%d periods after a 304, want %d", len(after), len(before))
+	}
+	for uid, period := range before {
+		if after[uid].Id != period.Id {
+			t.Errorf("period %s changed after a 304", uid)
+		}
+	}
+}
+
+func TestSyncExternalCalendarKeepsBlocksOfMalformedFeed(t *testing.T) {
+	server := newFeedServer(t)
+	fixture := newImportFixture(t, server.URL)
+	server.serve(fmt.Sprintf(validFeed, fixture.day(3), fixture.day(6), fixture.day(10), fixture.day(12)), "")
+	fixture.sync(t)
+
+	server.serve(malformedFeed, "")
+	calendar := fixture.sync(t)
+	if calendar.LastSyncError == "" {
+		t.Error("malformed feed synced without an error")
+	}
+	if periods := fixture.getPeriods(); len(periods) != 2 {
+		t.Errorf("%d periods after a malformed feed, want the 2 imported before", len(periods))
+	}
+}
+
+func TestSyncExternalCalendarReleasesRemovedEvent(t *testing.T) {
+	server := newFeedServer(t)
+	fixture := newImportFixture(t, server.URL)
+	server.serve(fmt.Sprintf(validFeed, fixture.day(3), fixture.day(6), fixture.day(10), fixture.day(12)), "")
+	fixture.sync(t)
+	first := fixture.getPeriods()["first@channel"]
+
+	server.serve(fmt.Sprintf(removedFeed, fixture.day(3), fixture.day(6)), "")
+	fixture.sync(t)
+	periods := fixture.getPeriods()
+	if _, ok := periods["second@channel"]; ok {
+		t.Error("period of the removed event was kept")
+	}
+	if periods["first@channel"].Id != first.Id {
+		t.Error("period of the remaining event was replaced")
+	}
+}
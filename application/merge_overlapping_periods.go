@@ -2,14 +2,49 @@ package application
 
 import (
 	"github.com/ZMS-DevOps/booking-service/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"time"
 )
 
+type mergeKey struct {
+	reason             domain.UnavailabilityReason
+	reservationId      primitive.ObjectID
+	externalCalendarId primitive.ObjectID
+	externalUID        string
+}
+
+// Only periods with the same reason and owning reservation or imported event are merged, so every
+// Reserved and External period keeps its own id and can be removed on its own.
 func mergeOverlappingPeriods(periods []domain.UnavailabilityPeriod) []domain.UnavailabilityPeriod {
 	if len(periods) <= 1 {
 		return periods
 	}
 
+	var keys []mergeKey
+	groups := make(map[mergeKey][]domain.UnavailabilityPeriod)
+	for _, period := range periods {
+		key := mergeKey{
+			reason:             period.Reason,
+			reservationId:      period.ReservationId,
+			externalCalendarId: period.ExternalCalendarId,
+			externalUID:        period.ExternalUID,
+		}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], period)
+	}
+
+	var mergedPeriods []domain.UnavailabilityPeriod
+	for _, key := range keys {
+		mergedPeriods = append(mergedPeriods, mergeGroup(groups[key])...)
+	}
+	sortPeriodsByStartTime(mergedPeriods)
+
+	return mergedPeriods
+}
+
+func mergeGroup(periods []domain.UnavailabilityPeriod) []domain.UnavailabilityPeriod {
 	sortPeriodsByStartTime(periods)
 
 	var mergedPeriods []domain.UnavailabilityPeriod
@@ -46,32 +81,62 @@ func maxTime(a, b time.Time) time.Time {
 	return b
 }
 
+func copyPeriods(periods []domain.UnavailabilityPeriod) []domain.UnavailabilityPeriod {
+	return append([]domain.UnavailabilityPeriod{}, periods...)
+}
+
 func insertPeriod(newPeriod *domain.UnavailabilityPeriod, periods []domain.UnavailabilityPeriod) []domain.UnavailabilityPeriod {
 	periods = append(periods, *newPeriod)
 	return mergeOverlappingPeriods(periods)
 }
 
-func removePeriod(toRemove domain.UnavailabilityPeriod, periods []domain.UnavailabilityPeriod, shouldRemainReserved bool) []domain.UnavailabilityPeriod {
+func removePeriod(toRemove domain.UnavailabilityPeriod, periods []domain.UnavailabilityPeriod) []domain.UnavailabilityPeriod {
 	var result []domain.UnavailabilityPeriod
 
 	for _, period := range periods {
-		if toRemove.Start.After(period.End) || toRemove.End.Before(period.Start) {
+		// A block starting as the removed range ends, or ending as it starts, only touches it and is kept whole.
+		if !periodsOverlap(toRemove.Start, toRemove.End, period.Start, period.End) || period.Reason != domain.OwnerSet {
 			result = append(result, period)
 		} else {
-			if period.Reason == domain.Reserved && shouldRemainReserved {
-				result = append(result, period)
-			} else {
-				if toRemove.Start.After(period.Start) && toRemove.End.Before(period.End) {
-					result = append(result, domain.UnavailabilityPeriod{Start: period.Start, End: toRemove.Start})
-					result = append(result, domain.UnavailabilityPeriod{Start: toRemove.End, End: period.End})
-				} else if toRemove.Start.After(period.Start) && toRemove.Start.Before(period.End) {
-					result = append(result, domain.UnavailabilityPeriod{Start: period.Start, End: toRemove.Start})
-				} else if toRemove.End.After(period.Start) && toRemove.End.Before(period.End) {
-					result = append(result, domain.UnavailabilityPeriod{Start: toRemove.End, End: period.End})
-				}
+			if toRemove.Start.After(period.Start) && toRemove.End.Before(period.End) {
+				result = append(result, trimPeriod(period, period.Start, toRemove.Start))
+				remainder := trimPeriod(period, toRemove.End, period.End)
+				remainder.Id = primitive.NewObjectID()
+				result = append(result, remainder)
+			} else if toRemove.Start.After(period.Start) && toRemove.Start.Before(period.End) {
+				result = append(result, trimPeriod(period, period.Start, toRemove.Start))
+			} else if toRemove.End.After(period.Start) && toRemove.End.Before(period.End) {
+				result = append(result, trimPeriod(period, toRemove.End, period.End))
 			}
-
 		}
 	}
 	return result
 }
+
+func trimPeriod(period domain.UnavailabilityPeriod, start time.Time, end time.Time) domain.UnavailabilityPeriod {
+	period.Start = start
+	period.End = end
+	return period
+}
+
+func removeReservationPeriods(reservationRequest *domain.ReservationRequest, periods []domain.UnavailabilityPeriod) (remaining []domain.UnavailabilityPeriod, removed []domain.UnavailabilityPeriod) {
+	for _, period := range periods {
+		if isReservationPeriod(period, reservationRequest) {
+			removed = append(removed, period)
+		} else {
+			remaining = append(remaining, period)
+		}
+	}
+	return remaining, removed
+}
+
+// Periods reserved before they were linked to a reservation are matched by their exact range.
+func isReservationPeriod(period domain.UnavailabilityPeriod, reservationRequest *domain.ReservationRequest) bool {
+	if period.Reason != domain.Reserved {
+		return false
+	}
+	if !period.ReservationId.IsZero() {
+		return period.ReservationId == reservationRequest.Id
+	}
+	return period.Start.Equal(reservationRequest.Start) && period.End.Equal(reservationRequest.End)
+}
@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+// enqueueMessage records an event in the outbox as part of the transaction, so it is published if and only if the
+// state change it announces is committed. The key keeps events about the same entity in order on their topic.
+func enqueueMessage(transaction domain.Transaction, outbox domain.OutboxStore, span trace.Span, topic string, key string, value interface{}) error {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	// Legacy notifications have no event types of their own; their topic names them.
+	return enqueuePayload(transaction, outbox, span, primitive.NewObjectID(), topic, topic, key, payload)
+}
+
+// enqueuePayload keeps the trace context of span, so consumers continue the trace of the request that caused the event.
+func enqueuePayload(transaction domain.Transaction, outbox domain.OutboxStore, span trace.Span, id primitive.ObjectID, eventType string, topic string, key string, payload []byte) error {
+	now := time.Now()
+	message := &domain.OutboxMessage{
+		Id:            id,
+		Type:          eventType,
+		TraceContext:  getTraceContext(span),
+		Topic:         topic,
+		Key:           key,
+		Payload:       payload,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}
+	if err := outbox.WithContext(transaction.Context()).Insert(message); err != nil {
+		return err
+	}
+	transaction.Compensate(func() error {
+		return outbox.Delete(message.Id)
+	})
+	return nil
+}
+
+func getTraceContext(span trace.Span) map[string]string {
+	if span == nil || !span.SpanContext().IsValid() {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(trace.ContextWithSpan(context.Background(), span), carrier)
+	return carrier
+}
@@ -0,0 +1,173 @@
+package application
+
+import (
+	"context"
+	"expvar"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"log"
+	"time"
+)
+
+const (
+	outboxRelayLease     = "outbox-relay"
+	outboxRelayLeaseTtl  = 30 * time.Second
+	outboxBatchSize      = 100
+	outboxInitialBackoff = time.Second
+	outboxMaxBackoff     = 5 * time.Minute
+)
+
+var (
+	outboxPending   = expvar.NewInt("outbox_pending_messages")
+	outboxLag       = expvar.NewFloat("outbox_lag_seconds")
+	outboxPublished = expvar.NewInt("outbox_published_total")
+	outboxFailed    = expvar.NewInt("outbox_failed_attempts_total")
+	outboxLastRelay = expvar.NewString("outbox_last_relay_at")
+)
+
+// OutboxRelay publishes recorded events in the order they were recorded. A message is marked delivered only after the
+// publisher accepted it, so delivery is at least once; consumers deduplicate by the event id.
+type OutboxRelay struct {
+	store      domain.OutboxStore
+	publisher  domain.EventPublisher
+	leaseStore domain.LeaseStore
+	interval   time.Duration
+	instanceId string
+}
+
+func NewOutboxRelay(store domain.OutboxStore, publisher domain.EventPublisher, leaseStore domain.LeaseStore, interval time.Duration, instanceId string) *OutboxRelay {
+	return &OutboxRelay{
+		store:      store,
+		publisher:  publisher,
+		leaseStore: leaseStore,
+		interval:   interval,
+		instanceId: instanceId,
+	}
+}
+
+func (relay *OutboxRelay) Start(ctx context.Context) {
+	ticker := time.NewTicker(relay.interval)
+	defer ticker.Stop()
+
+	for {
+		relay.run()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (relay *OutboxRelay) run() {
+	acquired, err := relay.acquireLease()
+	if err != nil {
+		log.Printf("failed to acquire %s lease: %v", outboxRelayLease, err)
+		return
+	}
+	if !acquired {
+		return
+	}
+
+	if err := relay.relayDue(); err != nil {
+		log.Printf("failed to relay outbox messages: %v", err)
+	}
+	relay.reportBacklog()
+}
+
+func (relay *OutboxRelay) acquireLease() (bool, error) {
+	return relay.leaseStore.Acquire(outboxRelayLease, relay.instanceId, relay.getLeaseTtl())
+}
+
+func (relay *OutboxRelay) getLeaseTtl() time.Duration {
+	return max(relay.interval, outboxRelayLeaseTtl)
+}
+
+// relayDue skips the rest of a key's messages once one of them fails, so later events about the same entity do not
+// overtake it; GetDue keeps holding them back until it is delivered. The run ends after a batch with failures, since
+// they usually mean Kafka is unavailable. The lease is renewed once half of it has passed, and the run ends if another
+// instance took it over in the meantime.
+func (relay *OutboxRelay) relayDue() error {
+	renewedAt := time.Now()
+	for {
+		messages, err := relay.store.GetDue(time.Now(), outboxBatchSize)
+		if err != nil {
+			return err
+		}
+
+		failedKeys := make(map[string]bool)
+		var publishErr error
+		for _, message := range messages {
+			if time.Since(renewedAt) > relay.getLeaseTtl()/2 {
+				renewed, err := relay.acquireLease()
+				if err != nil || !renewed {
+					return err
+				}
+				renewedAt = time.Now()
+			}
+			if failedKeys[message.Key] {
+				continue
+			}
+			if err := relay.publisher.Publish(mapOutboxEvent(message)); err != nil {
+				outboxFailed.Add(1)
+				nextAttemptAt := time.Now().Add(getOutboxBackoff(message.Attempts))
+				if markErr := relay.store.MarkFailed(message.Id, nextAttemptAt, err.Error()); markErr != nil {
+					return markErr
+				}
+				failedKeys[message.Key] = true
+				publishErr = err
+				continue
+			}
+			if err := relay.store.MarkDelivered(message.Id, time.Now()); err != nil {
+				return err
+			}
+			outboxPublished.Add(1)
+		}
+
+		if publishErr != nil {
+			return publishErr
+		}
+		if len(messages) < outboxBatchSize {
+			return nil
+		}
+	}
+}
+
+func (relay *OutboxRelay) reportBacklog() {
+	pending, oldest, err := relay.store.GetBacklog()
+	if err != nil {
+		log.Printf("failed to measure outbox backlog: %v", err)
+		return
+	}
+	now := time.Now()
+	outboxPending.Set(pending)
+	outboxLag.Set(0)
+	if pending > 0 {
+		outboxLag.Set(now.Sub(oldest).Seconds())
+	}
+	outboxLastRelay.Set(now.Format(time.RFC3339))
+}
+
+// Messages recorded before events had types are typed by their topic.
+func mapOutboxEvent(message *domain.OutboxMessage) domain.Event {
+	eventType := message.Type
+	if eventType == "" {
+		eventType = message.Topic
+	}
+	return domain.Event{
+		Id:           message.Id.Hex(),
+		Type:         eventType,
+		Time:         message.CreatedAt,
+		Topic:        message.Topic,
+		Key:          message.Key,
+		Payload:      message.Payload,
+		TraceContext: message.TraceContext,
+	}
+}
+
+func getOutboxBackoff(attempts int) time.Duration {
+	backoff := outboxInitialBackoff
+	for i := 0; i < attempts && backoff < outboxMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, outboxMaxBackoff)
+}
@@ -0,0 +1,68 @@
+package application
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+	"time"
+)
+
+func TestRelayDueHoldsBackLaterMessagesOfFailedKey(t *testing.T) {
+	now := time.Now()
+	newMessage := func(key string) *domain.OutboxMessage {
+		return &domain.OutboxMessage{Id: primitive.NewObjectID(), Topic: "booking.reservation.v1", Key: key, CreatedAt: now, NextAttemptAt: now}
+	}
+	firstOfA, firstOfB, secondOfA := newMessage("a"), newMessage("b"), newMessage("a")
+	store := &fakeOutboxStore{messages: []*domain.OutboxMessage{firstOfA, firstOfB, secondOfA}}
+	publisher := &fakeEventPublisher{failing: map[string]bool{firstOfA.Id.Hex(): true}}
+	relay := NewOutboxRelay(store, publisher, fakeLeaseStore{}, time.Second, "instance")
+
+	if err := relay.relayDue(); err == nil {
+		t.Error("relay reported no error for a failed message")
+	}
+
+	events := publisher.events
+	if len(events) != 1 || events[0].Id != firstOfB.Id.Hex() {
+		t.Fatalf("published %v, want only the message of the other key", events)
+	}
+	if secondOfA.DeliveredAt != nil || secondOfA.Attempts != 0 {
+		t.Error("message behind the failed one was relayed")
+	}
+	if !firstOfA.NextAttemptAt.After(now) {
+		t.Error("failed message was not scheduled for another attempt")
+	}
+}
+
+func TestRelayDuePublishesRecordedEvents(t *testing.T) {
+	store := &fakeOutboxStore{}
+	request := &domain.ReservationRequest{Id: primitive.NewObjectID(), AccommodationId: primitive.NewObjectID(), HostId: "host", UserId: "guest", Status: domain.Approved}
+	transaction := &fakeTransaction{}
+	if err := enqueueReservationEvent(transaction, store, noSpan, dto.ReservationEvent{Type: dto.ReservationApproved, Actor: dto.HostActor("host"), Reservation: request}); err != nil {
+		t.Fatal(err)
+	}
+	if err := enqueueMessage(transaction, store, noSpan, "host-reviewed-reservation-request", request.Id.Hex(), dto.NotificationDTO{UserId: "guest", ReservationId: request.Id.Hex(), Status: "accept-request"}); err != nil {
+		t.Fatal(err)
+	}
+
+	publisher := &fakeEventPublisher{}
+	if err := NewOutboxRelay(store, publisher, fakeLeaseStore{}, time.Second, "instance").relayDue(); err != nil {
+		t.Fatalf("relay failed: %v", err)
+	}
+
+	events := publisher.events
+	if len(events) != 2 {
+		t.Fatalf("published %d events, want 2", len(events))
+	}
+	if events[0].Type != dto.ReservationApproved || events[0].Topic != reservationEventsTopic || events[0].Key != request.Id.Hex() {
+		t.Errorf("first event is %s on %s keyed %s, want %s on %s keyed %s", events[0].Type, events[0].Topic, events[0].Key, dto.ReservationApproved, reservationEventsTopic, request.Id.Hex())
+	}
+	if events[1].Type != "host-reviewed-reservation-request" || events[1].Topic != "host-reviewed-reservation-request" {
+		t.Errorf("second event is %s on %s, want the legacy notification", events[1].Type, events[1].Topic)
+	}
+	for _, message := range store.messages {
+		if message.DeliveredAt == nil {
+			t.Errorf("message %s was not marked delivered", message.Id.Hex())
+		}
+	}
+}
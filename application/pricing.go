@@ -0,0 +1,127 @@
+package application
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+)
+
+const (
+	weeklyStayNights  = 7
+	monthlyStayNights = 28
+)
+
+func validatePricing(pricing domain.Pricing) error {
+	if pricing.Type != domain.PerUnit && pricing.Type != domain.PerGuest {
+		return &domain.ValidationError{Message: "unknown pricing type"}
+	}
+	currency := pricing.NightlyRate.Currency
+	if !domain.IsValidCurrency(currency) {
+		return &domain.ValidationError{Message: "pricing currency must be an ISO-4217 code"}
+	}
+	if pricing.NightlyRate.Amount <= 0 {
+		return &domain.ValidationError{Message: "nightly rate must be positive"}
+	}
+	for _, override := range pricing.Overrides {
+		if !override.Start.Before(override.End) {
+			return &domain.ValidationError{Message: "price override start must be before its end"}
+		}
+		if override.NightlyRate.Amount <= 0 {
+			return &domain.ValidationError{Message: "price override nightly rate must be positive"}
+		}
+		if override.NightlyRate.Currency != currency {
+			return &domain.ValidationError{Message: "price override currency must match the nightly rate"}
+		}
+	}
+	if !isPercentage(pricing.WeeklyDiscountPercentage) || !isPercentage(pricing.MonthlyDiscountPercentage) || !isPercentage(pricing.ServiceFeePercentage) {
+		return &domain.ValidationError{Message: "discounts and fees must be between 0 and 100 percent"}
+	}
+	if pricing.CleaningFee.Amount < 0 {
+		return &domain.ValidationError{Message: "cleaning fee cannot be negative"}
+	}
+	if pricing.CleaningFee.Currency != currency {
+		return &domain.ValidationError{Message: "cleaning fee currency must match the nightly rate"}
+	}
+	return nil
+}
+
+// Accommodations created before pricing existed, or through AddUnavailability and accommodation events, have no
+// nightly rate until their host sets one, and cannot be quoted or booked.
+func getPricing(unavailability *domain.Unavailability) (domain.Pricing, error) {
+	if unavailability.Pricing.NightlyRate.Amount <= 0 {
+		return domain.Pricing{}, domain.ErrPricingNotConfigured
+	}
+	return unavailability.Pricing, nil
+}
+
+func calculatePriceQuote(unavailability *domain.Unavailability, stay domain.Stay, numberOfGuests int) (*domain.PriceQuote, error) {
+	pricing, err := getPricing(unavailability)
+	if err != nil {
+		return nil, err
+	}
+	if stay.Nights() <= 0 {
+		return nil, &domain.ValidationError{Message: "check-out must be after check-in"}
+	}
+	if numberOfGuests <= 0 {
+		return nil, &domain.ValidationError{Message: "number of guests must be positive"}
+	}
+
+	zero := domain.Money{Currency: pricing.NightlyRate.Currency}
+	start, end := getStayBounds(unavailability, stay)
+	quote := &domain.PriceQuote{
+		AccommodationId: unavailability.AccommodationId,
+		Start:           start,
+		End:             end,
+		NumberOfGuests:  numberOfGuests,
+		Subtotal:        zero,
+	}
+
+	for night := stay.CheckIn; night.Before(stay.CheckOut); night = night.AddDays(1) {
+		price := getNightlyRate(unavailability, pricing, night)
+		if pricing.Type == domain.PerGuest {
+			price = price.Multiply(int64(numberOfGuests))
+		}
+		quote.Nights = append(quote.Nights, domain.NightlyPrice{Date: night, Price: price})
+		if quote.Subtotal, err = quote.Subtotal.Add(price); err != nil {
+			return nil, err
+		}
+	}
+
+	quote.Discount = quote.Subtotal.Percentage(getStayDiscountPercentage(pricing, len(quote.Nights)))
+	discounted, err := quote.Subtotal.Sub(quote.Discount)
+	if err != nil {
+		return nil, err
+	}
+	quote.ServiceFee = discounted.Percentage(pricing.ServiceFeePercentage)
+	if quote.CleaningFee, err = zero.Add(pricing.CleaningFee); err != nil {
+		return nil, err
+	}
+	if quote.Total, err = discounted.Add(quote.CleaningFee); err != nil {
+		return nil, err
+	}
+	if quote.Total, err = quote.Total.Add(quote.ServiceFee); err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+func getNightlyRate(unavailability *domain.Unavailability, pricing domain.Pricing, night domain.Date) domain.Money {
+	for _, override := range pricing.Overrides {
+		if storedDateRangeContains(unavailability, override.Start, override.End, night) {
+			return override.NightlyRate
+		}
+	}
+	return pricing.NightlyRate
+}
+
+func getStayDiscountPercentage(pricing domain.Pricing, nights int) int {
+	if nights >= monthlyStayNights && pricing.MonthlyDiscountPercentage > 0 {
+		return pricing.MonthlyDiscountPercentage
+	}
+	if nights >= weeklyStayNights {
+		return pricing.WeeklyDiscountPercentage
+	}
+	return 0
+}
+
+func isPercentage(value int) bool {
+	return value >= 0 && value <= 100
+}
@@ -0,0 +1,47 @@
+package application
+
+import (
+	"errors"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+	"time"
+)
+
+func TestCalculatePriceQuoteRequiresPricing(t *testing.T) {
+	unavailability := &domain.Unavailability{AccommodationId: primitive.NewObjectID()}
+	checkIn := domain.DateOf(time.Now(), time.UTC)
+	stay := domain.Stay{CheckIn: checkIn, CheckOut: checkIn.AddDays(3)}
+
+	if _, err := calculatePriceQuote(unavailability, stay, 2); !errors.Is(err, domain.ErrPricingNotConfigured) {
+		t.Errorf("quote for an accommodation without pricing returned %v, want pricing not configured", err)
+	}
+}
+
+func TestValidatePricingRejectsZeroCleaningFeeInOtherCurrency(t *testing.T) {
+	pricing := domain.Pricing{
+		Type:        domain.PerUnit,
+		NightlyRate: domain.Money{Amount: 10000, Currency: "EUR"},
+		CleaningFee: domain.Money{Currency: "USD"},
+	}
+	if err := validatePricing(pricing); err == nil {
+		t.Error("pricing with a USD cleaning fee and a EUR nightly rate was accepted")
+	}
+}
+
+func TestCalculatePriceQuoteReportsCurrencyMismatch(t *testing.T) {
+	unavailability := &domain.Unavailability{
+		AccommodationId: primitive.NewObjectID(),
+		Pricing: domain.Pricing{
+			Type:        domain.PerUnit,
+			NightlyRate: domain.Money{Amount: 10000, Currency: "EUR"},
+			CleaningFee: domain.Money{Currency: "USD"},
+		},
+	}
+	checkIn := domain.DateOf(time.Now(), time.UTC)
+
+	_, err := calculatePriceQuote(unavailability, domain.Stay{CheckIn: checkIn, CheckOut: checkIn.AddDays(2)}, 2)
+	if !errors.Is(err, domain.ErrCurrencyMismatch) {
+		t.Errorf("quote for pricing stored with mixed currencies returned %v, want a currency mismatch", err)
+	}
+}
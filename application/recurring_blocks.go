@@ -0,0 +1,532 @@
+package application
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
+	"github.com/ZMS-DevOps/booking-service/util"
+	"github.com/afiskon/promtail-client/promtail"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/trace"
+	"slices"
+	"time"
+)
+
+const (
+	// Bounds the search for a series' first occurrence; rules that yield nothing for this long are treated as empty.
+	maxRecurrenceSearchDays = 10 * 366
+	// Availability events announce the occurrences of a series up to two years ahead.
+	recurringBlockEventHorizonDays = 2 * 366
+)
+
+type occurrence struct {
+	recurrenceId domain.Date
+	stay         domain.Stay
+}
+
+func (service *UnavailabilityService) AddRecurringBlock(accommodationId primitive.ObjectID, block *domain.RecurringBlock, span trace.Span, loki promtail.Client) error {
+	if err := validateRecurringBlock(*block); err != nil {
+		return err
+	}
+	block.Id = primitive.NewObjectID()
+
+	return service.updateRecurringBlocks(accommodationId, "AddRecurringBlock", span, loki, func(unavailability *domain.Unavailability) error {
+		if err := checkRecurringBlockConflicts(unavailability, *block); err != nil {
+			return err
+		}
+		unavailability.RecurringBlocks = append(unavailability.RecurringBlocks, *block)
+		return nil
+	})
+}
+
+// Editing a series keeps the exceptions and moved occurrences that the new rule still produces.
+func (service *UnavailabilityService) UpdateRecurringBlock(accommodationId primitive.ObjectID, blockId primitive.ObjectID, block domain.RecurringBlock, span trace.Span, loki promtail.Client) error {
+	if err := validateRecurringBlock(block); err != nil {
+		return err
+	}
+
+	return service.updateRecurringBlocks(accommodationId, "UpdateRecurringBlock", span, loki, func(unavailability *domain.Unavailability) error {
+		index, err := findRecurringBlock(unavailability, blockId)
+		if err != nil {
+			return err
+		}
+		current := unavailability.RecurringBlocks[index]
+		updated := domain.RecurringBlock{
+			Id:     blockId,
+			Start:  block.Start,
+			Nights: block.Nights,
+			Rule:   block.Rule,
+		}
+		for _, exception := range slices.Concat(current.Exceptions, block.Exceptions) {
+			if isOccurrenceDate(updated, exception) && !slices.Contains(updated.Exceptions, exception) {
+				updated.Exceptions = append(updated.Exceptions, exception)
+			}
+		}
+		for _, modified := range current.ModifiedOccurrences {
+			if isOccurrenceDate(updated, modified.RecurrenceId) && !slices.Contains(updated.Exceptions, modified.RecurrenceId) {
+				updated.ModifiedOccurrences = append(updated.ModifiedOccurrences, modified)
+			}
+		}
+
+		if err := checkRecurringBlockConflicts(unavailability, updated); err != nil {
+			return err
+		}
+		unavailability.RecurringBlocks[index] = updated
+		return nil
+	})
+}
+
+func (service *UnavailabilityService) DeleteRecurringBlock(accommodationId primitive.ObjectID, blockId primitive.ObjectID, span trace.Span, loki promtail.Client) error {
+	return service.updateRecurringBlocks(accommodationId, "DeleteRecurringBlock", span, loki, func(unavailability *domain.Unavailability) error {
+		index, err := findRecurringBlock(unavailability, blockId)
+		if err != nil {
+			return err
+		}
+		unavailability.RecurringBlocks = slices.Delete(unavailability.RecurringBlocks, index, index+1)
+		return nil
+	})
+}
+
+// ModifyOccurrence moves a single occurrence of a series to other dates, identified by the date it replaces.
+func (service *UnavailabilityService) ModifyOccurrence(accommodationId primitive.ObjectID, blockId primitive.ObjectID, recurrenceId domain.Date, stay domain.Stay, span trace.Span, loki promtail.Client) error {
+	if stay.Nights() <= 0 {
+		return &domain.ValidationError{Message: "check-out must be after check-in"}
+	}
+
+	return service.updateRecurringBlocks(accommodationId, "ModifyOccurrence", span, loki, func(unavailability *domain.Unavailability) error {
+		index, err := findRecurringBlock(unavailability, blockId)
+		if err != nil {
+			return err
+		}
+		block := &unavailability.RecurringBlocks[index]
+		if !isOccurrenceDate(*block, recurrenceId) || slices.Contains(block.Exceptions, recurrenceId) {
+			return &domain.ValidationError{Message: "recurring block has no occurrence on " + recurrenceId.String()}
+		}
+
+		start, end := getStayBounds(unavailability, stay)
+		if conflictingPeriods := getReservedPeriods(findConflictingPeriods(unavailability, start, end)); len(conflictingPeriods) > 0 {
+			return &domain.ReservationConflictError{
+				AccommodationId:    accommodationId,
+				ConflictingPeriods: conflictingPeriods,
+			}
+		}
+
+		block.ModifiedOccurrences = slices.DeleteFunc(block.ModifiedOccurrences, func(modified domain.ModifiedOccurrence) bool {
+			return modified.RecurrenceId == recurrenceId
+		})
+		block.ModifiedOccurrences = append(block.ModifiedOccurrences, domain.ModifiedOccurrence{RecurrenceId: recurrenceId, Stay: stay})
+		return nil
+	})
+}
+
+// DeleteOccurrence removes a single occurrence of a series by adding it to the exceptions.
+func (service *UnavailabilityService) DeleteOccurrence(accommodationId primitive.ObjectID, blockId primitive.ObjectID, recurrenceId domain.Date, span trace.Span, loki promtail.Client) error {
+	return service.updateRecurringBlocks(accommodationId, "DeleteOccurrence", span, loki, func(unavailability *domain.Unavailability) error {
+		index, err := findRecurringBlock(unavailability, blockId)
+		if err != nil {
+			return err
+		}
+		block := &unavailability.RecurringBlocks[index]
+		if !isOccurrenceDate(*block, recurrenceId) {
+			return &domain.ValidationError{Message: "recurring block has no occurrence on " + recurrenceId.String()}
+		}
+
+		block.ModifiedOccurrences = slices.DeleteFunc(block.ModifiedOccurrences, func(modified domain.ModifiedOccurrence) bool {
+			return modified.RecurrenceId == recurrenceId
+		})
+		if !slices.Contains(block.Exceptions, recurrenceId) {
+			block.Exceptions = append(block.Exceptions, recurrenceId)
+		}
+		return nil
+	})
+}
+
+// updateRecurringBlocks announces the occurrences that an update adds, moves or removes, as far ahead as
+// recurringBlockEventHorizonDays.
+func (service *UnavailabilityService) updateRecurringBlocks(accommodationId primitive.ObjectID, function string, span trace.Span, loki promtail.Client, update func(unavailability *domain.Unavailability) error) error {
+	return retryOnConcurrentModification(func() error {
+		return service.transactions.WithTransaction(func(transaction domain.Transaction) error {
+			store := service.store.WithContext(transaction.Context())
+			util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, function, "")
+			unavailability, err := store.GetByAccommodationId(accommodationId)
+			if err != nil {
+				return err
+			}
+			if unavailability == nil {
+				return domain.ErrAccommodationNotFound
+			}
+
+			previous := *unavailability
+			previous.RecurringBlocks = copyRecurringBlocks(unavailability.RecurringBlocks)
+			if err := update(unavailability); err != nil {
+				return err
+			}
+
+			util.HttpTraceInfo("Updating recurring blocks...", span, loki, function, "")
+			if err := store.Update(unavailability.Id, unavailability); err != nil {
+				return err
+			}
+			transaction.Compensate(func() error {
+				restored := previous
+				restored.Version++
+				return service.store.Update(restored.Id, &restored)
+			})
+
+			return service.enqueueOccurrenceEvents(transaction, span, &previous, unavailability)
+		})
+	})
+}
+
+func (service *UnavailabilityService) enqueueOccurrenceEvents(transaction domain.Transaction, span trace.Span, previous *domain.Unavailability, updated *domain.Unavailability) error {
+	from := today(updated, time.Now())
+	to := from.AddDays(recurringBlockEventHorizonDays)
+	before := getOccurrencePeriods(previous, from, to)
+	after := getOccurrencePeriods(updated, from, to)
+
+	var events []dto.AvailabilityEvent
+	for _, key := range getSortedKeys(before) {
+		if period, ok := after[key]; !ok || !isSamePeriod(period, before[key]) {
+			events = append(events, newOccurrenceEvent(dto.PeriodUnblocked, previous, before[key]))
+		}
+	}
+	for _, key := range getSortedKeys(after) {
+		if period, ok := before[key]; !ok || !isSamePeriod(period, after[key]) {
+			events = append(events, newOccurrenceEvent(dto.PeriodBlocked, updated, after[key]))
+		}
+	}
+
+	for _, event := range events {
+		if err := enqueueAvailabilityEvent(transaction, service.outbox, span, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getOccurrencePeriods returns the occurrences touching [from, to) by block and recurrence date.
+func getOccurrencePeriods(unavailability *domain.Unavailability, from domain.Date, to domain.Date) map[string]domain.UnavailabilityPeriod {
+	periods := make(map[string]domain.UnavailabilityPeriod)
+	for _, period := range withOccurrences(unavailability, from, to).UnavailabilityPeriods {
+		if period.RecurrenceId != nil {
+			periods[getOccurrenceUID(period.Id.Hex(), *period.RecurrenceId)] = period
+		}
+	}
+	return periods
+}
+
+func getSortedKeys(periods map[string]domain.UnavailabilityPeriod) []string {
+	keys := make([]string, 0, len(periods))
+	for key := range periods {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func isSamePeriod(period domain.UnavailabilityPeriod, other domain.UnavailabilityPeriod) bool {
+	return period.Start.Equal(other.Start) && period.End.Equal(other.End)
+}
+
+func newOccurrenceEvent(eventType string, unavailability *domain.Unavailability, period domain.UnavailabilityPeriod) dto.AvailabilityEvent {
+	return dto.AvailabilityEvent{
+		Type:            eventType,
+		Actor:           dto.HostActor(unavailability.HostId),
+		AccommodationId: unavailability.AccommodationId,
+		HostId:          unavailability.HostId,
+		Period:          period,
+		Stay:            storedStay(unavailability, period.Start, period.End),
+	}
+}
+
+func copyRecurringBlocks(blocks []domain.RecurringBlock) []domain.RecurringBlock {
+	copied := slices.Clone(blocks)
+	for i := range copied {
+		copied[i].Exceptions = slices.Clone(blocks[i].Exceptions)
+		copied[i].ModifiedOccurrences = slices.Clone(blocks[i].ModifiedOccurrences)
+	}
+	return copied
+}
+
+func findRecurringBlock(unavailability *domain.Unavailability, blockId primitive.ObjectID) (int, error) {
+	for i, block := range unavailability.RecurringBlocks {
+		if block.Id == blockId {
+			return i, nil
+		}
+	}
+	return -1, domain.ErrRecurringBlockNotFound
+}
+
+func validateRecurringBlock(block domain.RecurringBlock) error {
+	rule := block.Rule
+	if block.Nights <= 0 || block.Nights > maxCalendarNights {
+		return &domain.ValidationError{Message: "recurring block must last between one night and a year"}
+	}
+	if rule.Interval <= 0 {
+		return &domain.ValidationError{Message: "recurrence interval must be positive"}
+	}
+	if rule.Until != nil && rule.Until.Before(block.Start) {
+		return &domain.ValidationError{Message: "recurrence cannot end before it starts"}
+	}
+	if rule.Frequency == domain.Weekly && len(rule.ByMonthDay) > 0 {
+		return &domain.ValidationError{Message: "weekly recurrence cannot use month days"}
+	}
+	for _, day := range rule.ByDay {
+		if day.Ordinal == 0 {
+			continue
+		}
+		if rule.Frequency == domain.Daily || rule.Frequency == domain.Weekly {
+			return &domain.ValidationError{Message: "numbered weekdays need a monthly or yearly recurrence"}
+		}
+		if rule.Frequency == domain.Yearly && len(rule.ByMonth) == 0 {
+			return &domain.ValidationError{Message: "numbered weekdays in a yearly recurrence need a month"}
+		}
+	}
+	return nil
+}
+
+// A recurring block may not cover a night that is already reserved.
+func checkRecurringBlockConflicts(unavailability *domain.Unavailability, block domain.RecurringBlock) error {
+	var conflictingPeriods []domain.UnavailabilityPeriod
+	for _, period := range unavailability.UnavailabilityPeriods {
+		if period.Reason != domain.Reserved {
+			continue
+		}
+		stay := storedStay(unavailability, period.Start, period.End)
+		if len(expandRecurringBlock(block, stay.CheckIn, stay.CheckOut)) > 0 {
+			conflictingPeriods = append(conflictingPeriods, period)
+		}
+	}
+	if len(conflictingPeriods) > 0 {
+		return &domain.ReservationConflictError{
+			AccommodationId:    unavailability.AccommodationId,
+			ConflictingPeriods: conflictingPeriods,
+		}
+	}
+	return nil
+}
+
+func getReservedPeriods(periods []domain.UnavailabilityPeriod) []domain.UnavailabilityPeriod {
+	var reservedPeriods []domain.UnavailabilityPeriod
+	for _, period := range periods {
+		if period.Reason == domain.Reserved {
+			reservedPeriods = append(reservedPeriods, period)
+		}
+	}
+	return reservedPeriods
+}
+
+// withOccurrences returns the unavailability with the recurring occurrences touching [from, to) added as owner periods.
+// The copy has no recurring blocks left, so it is never expanded twice and must not be written back.
+func withOccurrences(unavailability *domain.Unavailability, from domain.Date, to domain.Date) *domain.Unavailability {
+	if len(unavailability.RecurringBlocks) == 0 {
+		return unavailability
+	}
+	expanded := *unavailability
+	expanded.RecurringBlocks = nil
+	expanded.UnavailabilityPeriods = copyPeriods(unavailability.UnavailabilityPeriods)
+	for _, block := range unavailability.RecurringBlocks {
+		for _, occurrence := range expandRecurringBlock(block, from, to) {
+			start, end := getStayBounds(unavailability, occurrence.stay)
+			recurrenceId := occurrence.recurrenceId
+			expanded.UnavailabilityPeriods = append(expanded.UnavailabilityPeriods, domain.UnavailabilityPeriod{
+				Id:           block.Id,
+				Start:        start,
+				End:          end,
+				Reason:       domain.OwnerSet,
+				RecurrenceId: &recurrenceId,
+			})
+		}
+	}
+	return &expanded
+}
+
+func withOccurrencesAround(unavailability *domain.Unavailability, start time.Time, end time.Time) *domain.Unavailability {
+	if len(unavailability.RecurringBlocks) == 0 {
+		return unavailability
+	}
+	return withOccurrences(unavailability, storedDate(unavailability, start).AddDays(-1), storedDate(unavailability, end).AddDays(1))
+}
+
+// expandRecurringBlock returns the occurrences of a block whose nights overlap [from, to), moved occurrences included.
+func expandRecurringBlock(block domain.RecurringBlock, from domain.Date, to domain.Date) []occurrence {
+	window := domain.Stay{CheckIn: from, CheckOut: to}
+	modified := make(map[domain.Date]bool, len(block.ModifiedOccurrences))
+	for _, modifiedOccurrence := range block.ModifiedOccurrences {
+		modified[modifiedOccurrence.RecurrenceId] = true
+	}
+
+	var occurrences []occurrence
+	forEachRecurrenceDate(block, to, func(date domain.Date) bool {
+		if modified[date] || slices.Contains(block.Exceptions, date) {
+			return true
+		}
+		stay := domain.Stay{CheckIn: date, CheckOut: date.AddDays(block.Nights)}
+		if stay.Overlaps(window) {
+			occurrences = append(occurrences, occurrence{recurrenceId: date, stay: stay})
+		}
+		return true
+	})
+	for _, modifiedOccurrence := range block.ModifiedOccurrences {
+		if modifiedOccurrence.Stay.Overlaps(window) {
+			occurrences = append(occurrences, occurrence{recurrenceId: modifiedOccurrence.RecurrenceId, stay: modifiedOccurrence.Stay})
+		}
+	}
+	return occurrences
+}
+
+func isOccurrenceDate(block domain.RecurringBlock, date domain.Date) bool {
+	found := false
+	forEachRecurrenceDate(block, date.AddDays(1), func(occurrenceDate domain.Date) bool {
+		found = occurrenceDate == date
+		return !found
+	})
+	return found
+}
+
+// forEachRecurrenceDate passes the dates the rule yields, in order and before exceptions, to yield until it returns
+// false, the rule runs out, or the dates reach limit. COUNT is counted from the block start, so expansion always
+// begins there.
+func forEachRecurrenceDate(block domain.RecurringBlock, limit domain.Date, yield func(date domain.Date) bool) {
+	rule := block.Rule
+	count := 0
+	for index := 0; ; index++ {
+		periodStart, candidates := expandRecurrencePeriod(block, index)
+		if !periodStart.Before(limit) {
+			return
+		}
+		for _, date := range candidates {
+			if date.Before(block.Start) {
+				continue
+			}
+			if rule.Until != nil && date.After(*rule.Until) || !date.Before(limit) {
+				return
+			}
+			if !yield(date) {
+				return
+			}
+			count++
+			if rule.Count > 0 && count >= rule.Count {
+				return
+			}
+		}
+	}
+}
+
+// expandRecurrencePeriod returns the first date of the index-th day, week, month or year of the rule and the
+// candidate dates within it, sorted.
+func expandRecurrencePeriod(block domain.RecurringBlock, index int) (domain.Date, []domain.Date) {
+	rule := block.Rule
+	start := block.Start
+	switch rule.Frequency {
+	case domain.Daily:
+		date := start.AddDays(index * rule.Interval)
+		if matchesMonth(rule, date.Month) && matchesMonthDay(rule, date) && matchesWeekday(rule, date) {
+			return date, []domain.Date{date}
+		}
+		return date, nil
+	case domain.Weekly:
+		weekStart := start.AddDays(-daysSinceMonday(start.Weekday()) + 7*index*rule.Interval)
+		var candidates []domain.Date
+		for offset := 0; offset < 7; offset++ {
+			date := weekStart.AddDays(offset)
+			isRuleDay := len(rule.ByDay) == 0 && date.Weekday() == start.Weekday() || len(rule.ByDay) > 0 && matchesWeekday(rule, date)
+			if isRuleDay && matchesMonth(rule, date.Month) {
+				candidates = append(candidates, date)
+			}
+		}
+		return weekStart, candidates
+	case domain.Monthly:
+		monthStart := domain.Date{Year: start.Year, Month: start.Month, Day: 1}
+		monthStart = domain.DateOf(monthStart.At(domain.TimeOfDay{}, time.UTC).AddDate(0, index*rule.Interval, 0), time.UTC)
+		if !matchesMonth(rule, monthStart.Month) {
+			return monthStart, nil
+		}
+		return monthStart, expandMonth(rule, monthStart.Year, monthStart.Month, start.Day)
+	default:
+		year := start.Year + index*rule.Interval
+		months := rule.ByMonth
+		if len(months) == 0 && len(rule.ByDay) == 0 && len(rule.ByMonthDay) == 0 {
+			months = []time.Month{start.Month}
+		} else if len(months) == 0 {
+			months = []time.Month{time.January, time.February, time.March, time.April, time.May, time.June, time.July, time.August, time.September, time.October, time.November, time.December}
+		}
+		months = slices.Clone(months)
+		slices.Sort(months)
+		var candidates []domain.Date
+		for _, month := range months {
+			candidates = append(candidates, expandMonth(rule, year, month, start.Day)...)
+		}
+		return domain.Date{Year: year, Month: time.January, Day: 1}, candidates
+	}
+}
+
+// expandMonth applies BYMONTHDAY and BYDAY within one month; with neither, the block start's day of month is used and
+// months too short for it are skipped.
+func expandMonth(rule domain.RecurrenceRule, year int, month time.Month, defaultDay int) []domain.Date {
+	daysInMonth := getDaysInMonth(year, month)
+	var candidates []domain.Date
+	for day := 1; day <= daysInMonth; day++ {
+		date := domain.Date{Year: year, Month: month, Day: day}
+		if len(rule.ByMonthDay) == 0 && len(rule.ByDay) == 0 {
+			if day == defaultDay {
+				candidates = append(candidates, date)
+			}
+			continue
+		}
+		if (len(rule.ByMonthDay) == 0 || matchesMonthDay(rule, date)) && (len(rule.ByDay) == 0 || matchesMonthWeekday(rule, date, daysInMonth)) {
+			candidates = append(candidates, date)
+		}
+	}
+	return candidates
+}
+
+func matchesMonth(rule domain.RecurrenceRule, month time.Month) bool {
+	return len(rule.ByMonth) == 0 || slices.Contains(rule.ByMonth, month)
+}
+
+func matchesMonthDay(rule domain.RecurrenceRule, date domain.Date) bool {
+	if len(rule.ByMonthDay) == 0 {
+		return true
+	}
+	daysInMonth := getDaysInMonth(date.Year, date.Month)
+	for _, monthDay := range rule.ByMonthDay {
+		if monthDay == date.Day || monthDay < 0 && daysInMonth+monthDay+1 == date.Day {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesWeekday(rule domain.RecurrenceRule, date domain.Date) bool {
+	if len(rule.ByDay) == 0 {
+		return true
+	}
+	for _, day := range rule.ByDay {
+		if day.Weekday == date.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// A numbered weekday such as 2MO or -1FR matches only the second Monday or the last Friday of the month.
+func matchesMonthWeekday(rule domain.RecurrenceRule, date domain.Date, daysInMonth int) bool {
+	for _, day := range rule.ByDay {
+		if day.Weekday != date.Weekday() {
+			continue
+		}
+		if day.Ordinal == 0 ||
+			day.Ordinal > 0 && (date.Day-1)/7+1 == day.Ordinal ||
+			day.Ordinal < 0 && (daysInMonth-date.Day)/7+1 == -day.Ordinal {
+			return true
+		}
+	}
+	return false
+}
+
+func getDaysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func daysSinceMonday(weekday time.Weekday) int {
+	return (int(weekday) + 6) % 7
+}
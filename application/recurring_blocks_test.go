@@ -0,0 +1,54 @@
+package application
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+	"time"
+)
+
+func TestRecurringBlockChangesEnqueueAvailabilityEvents(t *testing.T) {
+	accommodationId := primitive.NewObjectID()
+	unavailabilities := newFakeUnavailabilityStore(&domain.Unavailability{Id: primitive.NewObjectID(), AccommodationId: accommodationId, HostId: "host"})
+	outbox := &fakeOutboxStore{}
+	service := NewUnavailabilityService(unavailabilities, fakeTransactionManager{}, outbox, newFakeReservationRequestStore(), nil, nil, noopLoki{})
+
+	start := domain.DateOf(time.Now(), time.UTC).AddDays(7)
+	block := &domain.RecurringBlock{Start: start, Nights: 2, Rule: domain.RecurrenceRule{Frequency: domain.Weekly, Interval: 1, Count: 3}}
+	if err := service.AddRecurringBlock(accommodationId, block, noSpan, noopLoki{}); err != nil {
+		t.Fatalf("adding the block failed: %v", err)
+	}
+	if blocked, unblocked := countAvailabilityEvents(outbox); blocked != 3 || unblocked != 0 {
+		t.Fatalf("adding the block enqueued %d blocked and %d unblocked events, want 3 and 0", blocked, unblocked)
+	}
+
+	outbox.messages = nil
+	moved := domain.Stay{CheckIn: start.AddDays(8), CheckOut: start.AddDays(10)}
+	if err := service.ModifyOccurrence(accommodationId, block.Id, start.AddDays(7), moved, noSpan, noopLoki{}); err != nil {
+		t.Fatalf("moving an occurrence failed: %v", err)
+	}
+	if blocked, unblocked := countAvailabilityEvents(outbox); blocked != 1 || unblocked != 1 {
+		t.Fatalf("moving an occurrence enqueued %d blocked and %d unblocked events, want 1 and 1", blocked, unblocked)
+	}
+
+	outbox.messages = nil
+	if err := service.DeleteRecurringBlock(accommodationId, block.Id, noSpan, noopLoki{}); err != nil {
+		t.Fatalf("deleting the block failed: %v", err)
+	}
+	if blocked, unblocked := countAvailabilityEvents(outbox); blocked != 0 || unblocked != 3 {
+		t.Fatalf("deleting the block enqueued %d blocked and %d unblocked events, want 0 and 3", blocked, unblocked)
+	}
+}
+
+func countAvailabilityEvents(outbox *fakeOutboxStore) (blocked int, unblocked int) {
+	for _, message := range outbox.messages {
+		switch message.Type {
+		case dto.PeriodBlocked:
+			blocked++
+		case dto.PeriodUnblocked:
+			unblocked++
+		}
+	}
+	return blocked, unblocked
+}
@@ -0,0 +1,35 @@
+package application
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+// Typed events from proto/reservation_events.proto. They are recorded next to the legacy notification topics, which
+// keep being published until their consumers have moved over.
+const (
+	reservationEventsTopic  = "booking.reservation.v1"
+	availabilityEventsTopic = "booking.availability.v1"
+)
+
+// The outbox message id doubles as the event id, so redeliveries of an event carry the same id.
+func enqueueReservationEvent(transaction domain.Transaction, outbox domain.OutboxStore, span trace.Span, event dto.ReservationEvent) error {
+	id := primitive.NewObjectID()
+	payload, err := dto.MarshalReservationEvent(id, time.Now(), event)
+	if err != nil {
+		return err
+	}
+	return enqueuePayload(transaction, outbox, span, id, event.Type, reservationEventsTopic, event.Reservation.Id.Hex(), payload)
+}
+
+func enqueueAvailabilityEvent(transaction domain.Transaction, outbox domain.OutboxStore, span trace.Span, event dto.AvailabilityEvent) error {
+	id := primitive.NewObjectID()
+	payload, err := dto.MarshalAvailabilityEvent(id, time.Now(), event)
+	if err != nil {
+		return err
+	}
+	return enqueuePayload(transaction, outbox, span, id, event.Type, availabilityEventsTopic, event.AccommodationId.Hex(), payload)
+}
@@ -0,0 +1,71 @@
+package application
+
+import (
+	"context"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/util"
+	"github.com/afiskon/promtail-client/promtail"
+	"go.opentelemetry.io/otel/trace"
+	"log"
+	"time"
+)
+
+const reservationLifecycleLease = "reservation-lifecycle"
+
+type ReservationLifecycleScheduler struct {
+	reservationRequestService *ReservationRequestService
+	leaseStore                domain.LeaseStore
+	interval                  time.Duration
+	instanceId                string
+	tracer                    trace.Tracer
+	loki                      promtail.Client
+}
+
+func NewReservationLifecycleScheduler(reservationRequestService *ReservationRequestService, leaseStore domain.LeaseStore, interval time.Duration, instanceId string, tracer trace.Tracer, loki promtail.Client) *ReservationLifecycleScheduler {
+	return &ReservationLifecycleScheduler{
+		reservationRequestService: reservationRequestService,
+		leaseStore:                leaseStore,
+		interval:                  interval,
+		instanceId:                instanceId,
+		tracer:                    tracer,
+		loki:                      loki,
+	}
+}
+
+func (scheduler *ReservationLifecycleScheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(scheduler.interval)
+	defer ticker.Stop()
+
+	for {
+		scheduler.run(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (scheduler *ReservationLifecycleScheduler) run(ctx context.Context) {
+	acquired, err := scheduler.leaseStore.Acquire(reservationLifecycleLease, scheduler.instanceId, scheduler.interval)
+	if err != nil {
+		log.Printf("failed to acquire %s lease: %v", reservationLifecycleLease, err)
+		return
+	}
+	if !acquired {
+		return
+	}
+
+	_, span := scheduler.tracer.Start(ctx, "reservation-lifecycle-job")
+	defer func() { span.End() }()
+
+	if err := scheduler.reservationRequestService.CompleteFinishedReservations(span, scheduler.loki); err != nil {
+		util.HttpTraceError(err, "failed to complete finished reservations", span, scheduler.loki, "ReservationLifecycleScheduler", "")
+	}
+	if err := scheduler.reservationRequestService.RemindHostsOfPendingRequests(span, scheduler.loki); err != nil {
+		util.HttpTraceError(err, "failed to remind hosts of pending requests", span, scheduler.loki, "ReservationLifecycleScheduler", "")
+	}
+	if err := scheduler.reservationRequestService.ExpirePendingRequests(span, scheduler.loki); err != nil {
+		util.HttpTraceError(err, "failed to expire pending requests", span, scheduler.loki, "ReservationLifecycleScheduler", "")
+	}
+}
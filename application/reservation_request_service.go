@@ -1,31 +1,35 @@
 package application
 
 import (
-	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/ZMS-DevOps/booking-service/domain"
 	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
 	"github.com/ZMS-DevOps/booking-service/util"
 	"github.com/afiskon/promtail-client/promtail"
-	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.opentelemetry.io/otel/trace"
-	"log"
 	"time"
 )
 
+// Stored ends of older requests are midnights rather than check-out instants, so candidates are fetched a little
+// ahead and completed once the accommodation's check-out time has passed.
+const checkOutLookahead = 48 * time.Hour
+
 type ReservationRequestService struct {
 	store                 domain.ReservationRequestStore
 	unavailabilityService UnavailabilityService
-	producer              *kafka.Producer
+	transactions          domain.TransactionManager
+	outbox                domain.OutboxStore
 	loki                  promtail.Client
 }
 
-func NewReservationRequestService(store domain.ReservationRequestStore, unavailabilityService *UnavailabilityService, producer *kafka.Producer, loki promtail.Client) *ReservationRequestService {
+func NewReservationRequestService(store domain.ReservationRequestStore, unavailabilityService *UnavailabilityService, transactions domain.TransactionManager, outbox domain.OutboxStore, loki promtail.Client) *ReservationRequestService {
 	return &ReservationRequestService{
 		store:                 store,
 		unavailabilityService: *unavailabilityService,
-		producer:              producer,
+		transactions:          transactions,
+		outbox:                outbox,
 		loki:                  loki,
 	}
 }
@@ -34,29 +38,92 @@ func (service *ReservationRequestService) AddReservationRequest(reservationReque
 	reservationRequest.Id = primitive.NewObjectID()
 	reservationRequest.Status = domain.Pending
 
-	isAutomatic, err := service.unavailabilityService.IsAutomatic(reservationRequest.AccommodationId, span, loki)
+	unavailability, err := service.unavailabilityService.GetByAccommodationId(reservationRequest.AccommodationId, span, loki)
 	if err != nil {
 		return err
 	}
+	if unavailability == nil {
+		return domain.ErrAccommodationNotFound
+	}
 
-	util.HttpTraceInfo("Adding reservation request...", span, loki, "AddReservationRequest", "")
-	requestId, err := service.store.Insert(reservationRequest)
+	stay := stayFromInput(reservationRequest.Start, reservationRequest.End)
+	if err := validateReservationRequest(reservationRequest, unavailability, stay, time.Now()); err != nil {
+		return err
+	}
+	reservationRequest.Start, reservationRequest.End = getStayBounds(unavailability, stay)
 
+	util.HttpTraceInfo("Checking accommodation availability...", span, loki, "AddReservationRequest", "")
+	conflictingPeriods := findStayConflicts(unavailability, stay)
+	if len(conflictingPeriods) > 0 {
+		return &domain.ReservationConflictError{
+			AccommodationId:    reservationRequest.AccommodationId,
+			ConflictingPeriods: conflictingPeriods,
+		}
+	}
+	if err := checkStayRules(unavailability, stay); err != nil {
+		return err
+	}
+	util.HttpTraceInfo("Calculating price quote...", span, loki, "AddReservationRequest", "")
+	quote, err := calculatePriceQuote(unavailability, stay, reservationRequest.NumberOfGuests)
 	if err != nil {
 		return err
 	}
-
-	if isAutomatic {
-		err = service.ApproveRequest(*requestId, span, loki)
-		log.Printf("prosao2")
-		service.produceNotification("reservation-request.created", reservationRequest.HostId, reservationRequest.Id.Hex(), "automatic")
-	} else {
-		service.produceNotification("reservation-request.created", reservationRequest.HostId, reservationRequest.Id.Hex(), "")
+	if reservationRequest.PriceTotal != quote.Total {
+		return &domain.ValidationError{Message: fmt.Sprintf("price total %s does not match quoted total %s", reservationRequest.PriceTotal, quote.Total)}
 	}
+	reservationRequest.PriceTotal = quote.Total
+	isAutomatic := unavailability.ReviewReservationRequestAutomatically
+
+	responseWindow := getResponseWindow(unavailability.ReservationRequestResponseWindow)
+	now := time.Now()
+	reservationRequest.ExpiresAt = now.Add(responseWindow)
+	reservationRequest.RemindAt = now.Add(responseWindow / 2)
 
+	util.HttpTraceInfo("Adding reservation request...", span, loki, "AddReservationRequest", "")
+	var requestId *primitive.ObjectID
+	err = service.transactions.WithTransaction(func(transaction domain.Transaction) error {
+		requestId, err = service.store.WithContext(transaction.Context()).Insert(reservationRequest)
+		if err != nil {
+			return err
+		}
+		transaction.Compensate(func() error {
+			return service.store.Delete(*requestId)
+		})
+		if err := service.produceReservationEvent(transaction, span, dto.ReservationEvent{
+			Type:        dto.ReservationRequested,
+			Actor:       dto.GuestActor(reservationRequest.UserId),
+			Reservation: reservationRequest,
+			Automatic:   isAutomatic,
+		}); err != nil {
+			return err
+		}
+		// An automatically reviewed request is announced once it has been approved.
+		if isAutomatic {
+			return nil
+		}
+		return service.produceNotification(transaction, span, "reservation-request.created", reservationRequest.HostId, reservationRequest.Id.Hex(), "")
+	})
 	if err != nil {
 		return err
 	}
+
+	if isAutomatic {
+		return service.approveRequest(*requestId, true, span, loki)
+	}
+	return nil
+}
+
+// Check-in today is still allowed; "today" is the date at the accommodation, not on the server.
+func validateReservationRequest(reservationRequest *domain.ReservationRequest, unavailability *domain.Unavailability, stay domain.Stay, now time.Time) error {
+	if stay.Nights() <= 0 {
+		return &domain.ValidationError{Message: "check-out must be after check-in"}
+	}
+	if stay.CheckIn.Before(today(unavailability, now)) {
+		return &domain.ValidationError{Message: "reservation cannot start in the past"}
+	}
+	if reservationRequest.NumberOfGuests <= 0 {
+		return &domain.ValidationError{Message: "number of guests must be positive"}
+	}
 	return nil
 }
 
@@ -71,51 +138,115 @@ func (service *ReservationRequestService) GetByAccommodationId(accommodationId p
 }
 
 func (service *ReservationRequestService) ApproveRequest(id primitive.ObjectID, span trace.Span, loki promtail.Client) error {
-	util.HttpTraceInfo("Fetching reservation requests by id...", span, loki, "GetByAccommodationId", "")
-	reservationRequest, err := service.store.Get(id)
-	if err != nil {
-		return err
-	}
-	if reservationRequest.Status != domain.Pending {
-		return errors.New("reservation is not pending")
-	}
+	return service.approveRequest(id, false, span, loki)
+}
 
-	reservationRequest.Status = domain.Approved
-	util.HttpTraceInfo("Updating reservation requests...", span, loki, "GetByAccommodationId", "")
-	err = service.store.Update(id, reservationRequest)
-	if err != nil {
-		return err
-	}
-	util.HttpTraceInfo("Canceling overlapping pending requests...", span, loki, "GetByAccommodationId", "")
-	err = service.store.CancelOverlappingPendingRequests(reservationRequest)
-	err = service.createUnavailabilityPeriod(reservationRequest, span, loki)
-	if err != nil {
-		return err
-	}
-	log.Printf("stigao ovde")
-	service.produceNotification("host-reviewed-reservation-request", reservationRequest.UserId, reservationRequest.Id.Hex(), "accept-request")
-	log.Printf("prosao")
-	return nil
+func (service *ReservationRequestService) approveRequest(id primitive.ObjectID, automatic bool, span trace.Span, loki promtail.Client) error {
+	return retryOnConcurrentModification(func() error {
+		return service.transactions.WithTransaction(func(transaction domain.Transaction) error {
+			store := service.store.WithContext(transaction.Context())
+			util.HttpTraceInfo("Fetching reservation requests by id...", span, loki, "ApproveRequest", "")
+			request, err := store.Get(id)
+			if err != nil {
+				return err
+			}
+			if request.Status != domain.Pending {
+				return errors.New("reservation is not pending")
+			}
+
+			actor := dto.HostActor(request.HostId)
+			if automatic {
+				actor = dto.SystemActor
+			}
+			conflictStart, conflictEnd, err := service.unavailabilityService.addReservedPeriod(transaction, request, actor, span, loki)
+			if err != nil {
+				return err
+			}
+
+			util.HttpTraceInfo("Updating reservation requests...", span, loki, "ApproveRequest", "")
+			approved, err := store.UpdateStatus(id, domain.Pending, domain.Approved)
+			if err != nil {
+				return err
+			}
+			if !approved {
+				return errors.New("reservation is not pending")
+			}
+			transaction.Compensate(func() error {
+				_, err := service.store.UpdateStatus(id, domain.Approved, domain.Pending)
+				return err
+			})
+
+			util.HttpTraceInfo("Canceling overlapping pending requests...", span, loki, "ApproveRequest", "")
+			canceledIds, err := store.CancelOverlappingPendingRequests(request, conflictStart, conflictEnd)
+			if err != nil {
+				return err
+			}
+			transaction.Compensate(func() error {
+				return service.store.RestorePendingRequests(canceledIds)
+			})
+
+			request.Status = domain.Approved
+			if err := service.produceReservationEvent(transaction, span, dto.ReservationEvent{
+				Type:               dto.ReservationApproved,
+				Actor:              actor,
+				Reservation:        request,
+				Automatic:          automatic,
+				DeclinedRequestIds: canceledIds,
+			}); err != nil {
+				return err
+			}
+			for _, canceledId := range canceledIds {
+				canceledRequest, err := store.Get(canceledId)
+				if err != nil {
+					return err
+				}
+				if err := service.produceReservationEvent(transaction, span, dto.ReservationEvent{Type: dto.ReservationDeclined, Actor: actor, Reservation: canceledRequest}); err != nil {
+					return err
+				}
+			}
+
+			if err := service.produceNotification(transaction, span, "host-reviewed-reservation-request", request.UserId, request.Id.Hex(), "accept-request"); err != nil {
+				return err
+			}
+			if automatic {
+				return service.produceNotification(transaction, span, "reservation-request.created", request.HostId, request.Id.Hex(), "automatic")
+			}
+			return nil
+		})
+	})
 }
 
 func (service *ReservationRequestService) DeclineRequest(id primitive.ObjectID, span trace.Span, loki promtail.Client) error {
-	util.HttpTraceInfo("Fetching reservation requests by id...", span, loki, "DeclineRequest", "")
-	reservationRequest, err := service.store.Get(id)
-	if err != nil {
-		return err
-	}
-	if reservationRequest.Status != domain.Pending {
-		return errors.New("reservation is not pending")
-	}
+	return service.transactions.WithTransaction(func(transaction domain.Transaction) error {
+		store := service.store.WithContext(transaction.Context())
+		util.HttpTraceInfo("Fetching reservation requests by id...", span, loki, "DeclineRequest", "")
+		request, err := store.Get(id)
+		if err != nil {
+			return err
+		}
+		if request.Status != domain.Pending {
+			return errors.New("reservation is not pending")
+		}
 
-	reservationRequest.Status = domain.DeclinedByHost
-	util.HttpTraceInfo("Updating reservation requests...", span, loki, "DeclineRequest", "")
-	err = service.store.Update(id, reservationRequest)
-	if err != nil {
-		return err
-	}
-	service.produceNotification("host-reviewed-reservation-request", reservationRequest.UserId, reservationRequest.Id.Hex(), "decline-request")
-	return nil
+		util.HttpTraceInfo("Updating reservation requests...", span, loki, "DeclineRequest", "")
+		declined, err := store.UpdateStatus(id, domain.Pending, domain.DeclinedByHost)
+		if err != nil {
+			return err
+		}
+		if !declined {
+			return errors.New("reservation is not pending")
+		}
+		transaction.Compensate(func() error {
+			_, err := service.store.UpdateStatus(id, domain.DeclinedByHost, domain.Pending)
+			return err
+		})
+
+		request.Status = domain.DeclinedByHost
+		if err := service.produceReservationEvent(transaction, span, dto.ReservationEvent{Type: dto.ReservationDeclined, Actor: dto.HostActor(request.HostId), Reservation: request}); err != nil {
+			return err
+		}
+		return service.produceNotification(transaction, span, "host-reviewed-reservation-request", request.UserId, request.Id.Hex(), "decline-request")
+	})
 }
 
 func (service *ReservationRequestService) DeleteRequest(id primitive.ObjectID, span trace.Span, loki promtail.Client) error {
@@ -127,76 +258,139 @@ func (service *ReservationRequestService) DeleteRequest(id primitive.ObjectID, s
 	return nil
 }
 
-func (service *ReservationRequestService) createUnavailabilityPeriod(reservationRequest *domain.ReservationRequest, span trace.Span, loki promtail.Client) error {
-	unavailabilityPeriod := domain.UnavailabilityPeriod{
-		Start:  reservationRequest.Start,
-		End:    reservationRequest.End,
-		Reason: domain.Reserved,
-	}
-	err := service.unavailabilityService.AddUnavailabilityPeriod(reservationRequest.AccommodationId, &unavailabilityPeriod, span, loki)
-	return err
+func (service *ReservationRequestService) DeclineReservation(id primitive.ObjectID, span trace.Span, loki promtail.Client) error {
+	return retryOnConcurrentModification(func() error {
+		return service.transactions.WithTransaction(func(transaction domain.Transaction) error {
+			store := service.store.WithContext(transaction.Context())
+			util.HttpTraceInfo("Fetching reservation requests by id...", span, loki, "DeclineReservation", "")
+			request, err := store.Get(id)
+			if err != nil {
+				return err
+			}
+			if request.Status != domain.Approved {
+				return errors.New("reservation is not approved")
+			}
+
+			util.HttpTraceInfo("Fetching cancellation policy...", span, loki, "DeclineReservation", "")
+			unavailability, err := service.unavailabilityService.store.WithContext(transaction.Context()).GetByAccommodationId(request.AccommodationId)
+			if err != nil {
+				return err
+			}
+			if unavailability == nil {
+				unavailability = &domain.Unavailability{AccommodationId: request.AccommodationId}
+			}
+
+			canceledAt := time.Now()
+			stay := storedStay(unavailability, request.Start, request.End)
+			checkIn, _ := getStayBounds(unavailability, stay)
+			if !canceledAt.Before(checkIn) {
+				return errors.New("reservation has already started")
+			}
+			daysBeforeCheckIn := today(unavailability, canceledAt).DaysUntil(stay.CheckIn)
+			previous := *request
+			request.RefundAmount = calculateRefund(unavailability.CancellationPolicy, request.PriceTotal, daysBeforeCheckIn)
+			request.CanceledAt = canceledAt
+
+			util.HttpTraceInfo("Updating reservation requests...", span, loki, "DeclineReservation", "")
+			declined, err := store.CancelReservation(id, request.RefundAmount, canceledAt)
+			if err != nil {
+				return err
+			}
+			if !declined {
+				return errors.New("reservation is not approved")
+			}
+			transaction.Compensate(func() error {
+				return service.store.RestoreCanceledReservation(id, &previous)
+			})
+
+			guest := dto.GuestActor(request.UserId)
+			if err := service.unavailabilityService.removeReservedPeriod(transaction, request, guest, span, loki); err != nil {
+				return err
+			}
+
+			request.Status = domain.DeclinedByUser
+			if err := service.produceReservationEvent(transaction, span, dto.ReservationEvent{
+				Type:              dto.ReservationCanceledByGuest,
+				Actor:             guest,
+				Reservation:       request,
+				DaysBeforeCheckIn: daysBeforeCheckIn,
+			}); err != nil {
+				return err
+			}
+
+			refundAmount := dto.MapMoneyDto(request.RefundAmount)
+			return service.sendNotification(transaction, span, "reservation.canceled", dto.NotificationDTO{
+				UserId:        request.HostId,
+				ReservationId: request.Id.Hex(),
+				Status:        "canceled",
+				RefundAmount:  &refundAmount,
+			})
+		})
+	})
 }
 
-func (service *ReservationRequestService) DeclineReservation(id primitive.ObjectID, span trace.Span, loki promtail.Client) error {
-	util.HttpTraceInfo("Fetching reservation requests by id...", span, loki, "DeclineReservation", "")
-	reservationRequest, err := service.store.Get(id)
+// CanDeleteClient reports whether a guest has no stay in progress or ahead. Their requests are kept and anonymized once
+// the user service announces the deletion.
+func (service *ReservationRequestService) CanDeleteClient(clientId string, span trace.Span, loki promtail.Client) bool {
+	util.HttpTraceInfo("Fetching reservation requests by client id...", span, loki, "CanDeleteClient", "")
+	reservationRequests, err := service.store.GetByClientId(clientId)
 	if err != nil {
-		return err
-	}
-	if reservationRequest.Status != domain.Approved {
-		return errors.New("reservation is not approved")
-	}
-
-	if !isReservationInFuture(reservationRequest) {
-		return errors.New("reservation is in the future")
+		return false
 	}
+	return !service.hasActiveOrUpcomingReservation(reservationRequests, time.Now())
+}
 
-	reservationRequest.Status = domain.DeclinedByUser
-	util.HttpTraceInfo("Updating reservation requests...", span, loki, "DeclineReservation", "")
-	err = service.store.Update(id, reservationRequest)
-	if err != nil {
+// anonymizeClient replaces a deleted guest's id with a tombstone id of its own, so hosts keep their booking history and
+// revenue without it being traceable to the guest, and confirms this to the account deletion saga. The guest's pending
+// requests are declined first, so no stay can be approved for the deleted account afterwards.
+func (service *ReservationRequestService) anonymizeClient(transaction domain.Transaction, clientId string, deletionEventId string, span trace.Span, loki promtail.Client) error {
+	if err := service.declinePendingRequestsOfClient(transaction, clientId, span, loki); err != nil {
 		return err
 	}
 
-	unavailabilityPeriod := domain.UnavailabilityPeriod{
-		Start: reservationRequest.Start,
-		End:   reservationRequest.End,
-	}
-
-	log.Printf("stigao DeclineReservation")
-	log.Printf("unavailabilityPeriod start %s", unavailabilityPeriod.Start)
-	log.Printf("unavailabilityPeriod end %s", unavailabilityPeriod.End)
-	err = service.unavailabilityService.RemoveUnavailabilityPeriod(reservationRequest.AccommodationId, &unavailabilityPeriod, false, span, loki)
+	anonymizedAt := time.Now()
+	util.HttpTraceInfo("Anonymizing reservation requests by client id...", span, loki, "anonymizeClient", "")
+	anonymized, err := service.store.WithContext(transaction.Context()).AnonymizeClient(clientId, domain.TombstoneUserIdPrefix+primitive.NewObjectID().Hex(), anonymizedAt)
 	if err != nil {
 		return err
 	}
-
-	log.Printf("stigao do produce")
-	service.produceNotification("reservation.canceled", reservationRequest.HostId, reservationRequest.Id.Hex(), "canceled")
-
-	log.Printf("prosao DeclineReservation")
-	return nil
+	return enqueueMessage(transaction, service.outbox, span, userAnonymizedTopic, clientId, dto.UserAnonymizedDto{
+		UserId:                 clientId,
+		DeletionEventId:        deletionEventId,
+		AnonymizedReservations: anonymized,
+		AnonymizedAt:           anonymizedAt,
+	})
 }
 
-func (service *ReservationRequestService) DeleteClient(clientId string, span trace.Span, loki promtail.Client) bool {
-	util.HttpTraceInfo("Fetching reservation requests by client id...", span, loki, "DeleteClient", "")
-	reservationRequests, err := service.store.GetByClientId(clientId)
+func (service *ReservationRequestService) declinePendingRequestsOfClient(transaction domain.Transaction, clientId string, span trace.Span, loki promtail.Client) error {
+	store := service.store.WithContext(transaction.Context())
+	util.HttpTraceInfo("Fetching pending reservation requests by client id...", span, loki, "anonymizeClient", "")
+	pendingRequests, err := store.GetByClientIdAndStatus(clientId, domain.Pending)
 	if err != nil {
-		return false
-	}
-	for _, reservationRequest := range reservationRequests {
-		if isReservationInFuture(reservationRequest) && reservationRequest.Status == domain.Approved {
-			return false
-		}
+		return err
 	}
-	for _, reservationRequest := range reservationRequests {
-		util.HttpTraceInfo("Deleting reservation requests by id...", span, loki, "DeleteClient", "")
-		err = service.store.Delete(reservationRequest.Id)
+
+	for _, request := range pendingRequests {
+		util.HttpTraceInfo("Declining pending reservation request of deleted client...", span, loki, "anonymizeClient", request.Id.Hex())
+		declined, err := store.UpdateStatus(request.Id, domain.Pending, domain.DeclinedByUser)
 		if err != nil {
-			return false
+			return err
+		}
+		if !declined {
+			continue
+		}
+		id := request.Id
+		transaction.Compensate(func() error {
+			_, err := service.store.UpdateStatus(id, domain.DeclinedByUser, domain.Pending)
+			return err
+		})
+
+		request.Status = domain.DeclinedByUser
+		if err := service.produceReservationEvent(transaction, span, dto.ReservationEvent{Type: dto.ReservationDeclined, Actor: dto.SystemActor, Reservation: request}); err != nil {
+			return err
 		}
 	}
-	return true
+	return nil
 }
 
 func (service *ReservationRequestService) GetByClientId(clientId string, status *domain.ReservationRequestStatus, span trace.Span, loki promtail.Client) ([]*domain.ReservationRequest, error) {
@@ -238,36 +432,202 @@ func (service *ReservationRequestService) GetFilteredRequests(userId string, use
 	return requests, nil
 }
 
-func isReservationInFuture(reservationRequest *domain.ReservationRequest) bool {
-	today := time.Now()
-	if !today.Before(reservationRequest.Start.AddDate(0, 0, -1)) {
-		return false
+func (service *ReservationRequestService) CompleteFinishedReservations(span trace.Span, loki promtail.Client) error {
+	util.HttpTraceInfo("Fetching finished approved reservations...", span, loki, "CompleteFinishedReservations", "")
+	now := time.Now()
+	reservationRequests, err := service.store.GetApprovedEndedBefore(now.Add(checkOutLookahead))
+	if err != nil {
+		return err
 	}
-	return true
+	unavailabilityByAccommodation, err := service.getUnavailabilityByAccommodation(reservationRequests)
+	if err != nil {
+		return err
+	}
+
+	for _, reservationRequest := range reservationRequests {
+		unavailability := unavailabilityByAccommodation[reservationRequest.AccommodationId]
+		_, checkOut := getStayBounds(unavailability, storedStay(unavailability, reservationRequest.Start, reservationRequest.End))
+		if now.Before(checkOut) {
+			continue
+		}
+		completed, err := service.updateStatusAndNotify(reservationRequest.Id, domain.Approved, domain.Completed, func(transaction domain.Transaction) error {
+			reservationRequest.Status = domain.Completed
+			if err := service.produceReservationEvent(transaction, span, dto.ReservationEvent{Type: dto.ReservationCompleted, Actor: dto.SystemActor, Reservation: reservationRequest}); err != nil {
+				return err
+			}
+			return service.produceNotification(transaction, span, "reservation.completed", reservationRequest.UserId, reservationRequest.Id.Hex(), "completed")
+		})
+		if err != nil {
+			return err
+		}
+		if completed {
+			util.HttpTraceInfo("Reservation completed", span, loki, "CompleteFinishedReservations", reservationRequest.Id.Hex())
+		}
+	}
+	return nil
+}
+
+func (service *ReservationRequestService) ExpirePendingRequests(span trace.Span, loki promtail.Client) error {
+	util.HttpTraceInfo("Fetching expired pending reservation requests...", span, loki, "ExpirePendingRequests", "")
+	reservationRequests, err := service.store.GetPendingExpiredBefore(time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, reservationRequest := range reservationRequests {
+		expired, err := service.updateStatusAndNotify(reservationRequest.Id, domain.Pending, domain.Expired, func(transaction domain.Transaction) error {
+			reservationRequest.Status = domain.Expired
+			if err := service.produceReservationEvent(transaction, span, dto.ReservationEvent{Type: dto.ReservationExpired, Actor: dto.SystemActor, Reservation: reservationRequest}); err != nil {
+				return err
+			}
+			if err := service.produceNotification(transaction, span, "reservation-request.expired", reservationRequest.UserId, reservationRequest.Id.Hex(), "expired"); err != nil {
+				return err
+			}
+			return service.produceNotification(transaction, span, "reservation-request.expired", reservationRequest.HostId, reservationRequest.Id.Hex(), "expired")
+		})
+		if err != nil {
+			return err
+		}
+		if expired {
+			util.HttpTraceInfo("Reservation request expired", span, loki, "ExpirePendingRequests", reservationRequest.Id.Hex())
+		}
+	}
+	return nil
+}
+
+func (service *ReservationRequestService) RemindHostsOfPendingRequests(span trace.Span, loki promtail.Client) error {
+	util.HttpTraceInfo("Fetching pending reservation requests to remind hosts about...", span, loki, "RemindHostsOfPendingRequests", "")
+	reservationRequests, err := service.store.GetPendingToRemindBefore(time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, reservationRequest := range reservationRequests {
+		err := service.transactions.WithTransaction(func(transaction domain.Transaction) error {
+			reminded, err := service.store.WithContext(transaction.Context()).MarkHostReminded(reservationRequest.Id)
+			if err != nil || !reminded {
+				return err
+			}
+			return service.produceNotification(transaction, span, "reservation-request.reminder", reservationRequest.HostId, reservationRequest.Id.Hex(), "reminder")
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// An approved stay blocks deletion until its guest has checked out, judged by the accommodation's own check-out time.
+// Requests whose accommodation is gone can no longer be stayed in and are ignored.
+func (service *ReservationRequestService) hasActiveOrUpcomingReservation(reservationRequests []*domain.ReservationRequest, now time.Time) bool {
+	reservationRequest, err := service.findActiveOrUpcomingReservation(reservationRequests, now)
+	return err != nil || reservationRequest != nil
+}
+
+func (service *ReservationRequestService) findActiveOrUpcomingReservation(reservationRequests []*domain.ReservationRequest, now time.Time) (*domain.ReservationRequest, error) {
+	unavailabilityByAccommodation, err := service.getUnavailabilityByAccommodation(reservationRequests)
+	if err != nil {
+		return nil, err
+	}
+	for _, reservationRequest := range reservationRequests {
+		unavailability := unavailabilityByAccommodation[reservationRequest.AccommodationId]
+		if reservationRequest.Status != domain.Approved || unavailability == nil {
+			continue
+		}
+		_, checkOut := getStayBounds(unavailability, storedStay(unavailability, reservationRequest.Start, reservationRequest.End))
+		if now.Before(checkOut) {
+			return reservationRequest, nil
+		}
+	}
+	return nil, nil
+}
+
+// checkAccommodationDeletable applies the guard of CheckAccommodationHasReservation to deletions announced by the
+// accommodation service. Retrying would hold up the topic until the guest checks out, so the deletion is rejected.
+func (service *ReservationRequestService) checkAccommodationDeletable(accommodationId primitive.ObjectID, span trace.Span, loki promtail.Client) error {
+	status := domain.Approved
+	reservationRequests, err := service.GetByAccommodationId(accommodationId, &status, span, loki)
+	if err != nil {
+		return err
+	}
+	reservationRequest, err := service.findActiveOrUpcomingReservation(reservationRequests, time.Now())
+	if err != nil {
+		return err
+	}
+	if reservationRequest != nil {
+		return &domain.ValidationError{Message: fmt.Sprintf("accommodation %s cannot be deleted before reservation %s has checked out", accommodationId.Hex(), reservationRequest.Id.Hex())}
+	}
+	return nil
+}
+
+func (service *ReservationRequestService) getUnavailabilityByAccommodation(reservationRequests []*domain.ReservationRequest) (map[primitive.ObjectID]*domain.Unavailability, error) {
+	var accommodationIds []primitive.ObjectID
+	seen := make(map[primitive.ObjectID]bool)
+	for _, reservationRequest := range reservationRequests {
+		if !seen[reservationRequest.AccommodationId] {
+			seen[reservationRequest.AccommodationId] = true
+			accommodationIds = append(accommodationIds, reservationRequest.AccommodationId)
+		}
+	}
+	unavailabilityByAccommodation := make(map[primitive.ObjectID]*domain.Unavailability, len(accommodationIds))
+	if len(accommodationIds) == 0 {
+		return unavailabilityByAccommodation, nil
+	}
+	unavailabilityList, err := service.unavailabilityService.store.GetByAccommodationIds(accommodationIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, unavailability := range unavailabilityList {
+		unavailabilityByAccommodation[unavailability.AccommodationId] = unavailability
+	}
+	return unavailabilityByAccommodation, nil
+}
+
+// updateStatusAndNotify moves a request from one status to another and records its notifications in the same
+// transaction. It reports false when the request was no longer in the expected status.
+func (service *ReservationRequestService) updateStatusAndNotify(id primitive.ObjectID, current domain.ReservationRequestStatus, next domain.ReservationRequestStatus, notify func(transaction domain.Transaction) error) (bool, error) {
+	var updated bool
+	err := service.transactions.WithTransaction(func(transaction domain.Transaction) error {
+		var err error
+		updated, err = service.store.WithContext(transaction.Context()).UpdateStatus(id, current, next)
+		if err != nil || !updated {
+			return err
+		}
+		transaction.Compensate(func() error {
+			_, err := service.store.UpdateStatus(id, next, current)
+			return err
+		})
+		return notify(transaction)
+	})
+	return updated && err == nil, err
 }
 
-func (service *ReservationRequestService) produceNotification(topic string, receiverId string, reservationId string, status string) {
-	notificationDTO := dto.NotificationDTO{
+func (service *ReservationRequestService) produceNotification(transaction domain.Transaction, span trace.Span, topic string, receiverId string, reservationId string, status string) error {
+	return service.sendNotification(transaction, span, topic, dto.NotificationDTO{
 		UserId:        receiverId,
 		ReservationId: reservationId,
 		Status:        status,
-	}
-	message, _ := json.Marshal(notificationDTO)
-	err := service.producer.Produce(&kafka.Message{
-		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
-		Value:          message,
-	}, nil)
+	})
+}
 
+func (service *ReservationRequestService) sendNotification(transaction domain.Transaction, span trace.Span, topic string, notificationDTO dto.NotificationDTO) error {
+	return enqueueMessage(transaction, service.outbox, span, topic, notificationDTO.ReservationId, notificationDTO)
+}
+
+// produceReservationEvent records a typed event next to the legacy notifications; the stay dates of its snapshot are
+// read in the accommodation's zone.
+func (service *ReservationRequestService) produceReservationEvent(transaction domain.Transaction, span trace.Span, event dto.ReservationEvent) error {
+	unavailability, err := service.unavailabilityService.store.WithContext(transaction.Context()).GetByAccommodationId(event.Reservation.AccommodationId)
 	if err != nil {
-		log.Fatalf("Failed to produce message: %s", err)
+		return err
 	}
-
-	service.producer.Flush(4 * 1000)
+	event.Stay = storedStay(unavailability, event.Reservation.Start, event.Reservation.End)
+	return enqueueReservationEvent(transaction, service.outbox, span, event)
 }
 
 func (service *ReservationRequestService) CheckGuestHasReservationForHost(reviewerId string, hostId string, span trace.Span, loki promtail.Client) bool {
 	util.HttpTraceInfo("Fetching reservation requests by host id and accommodation id...", span, loki, "CheckGuestHasReservationForHost", "")
-	requests, err := service.store.GetPastAcceptedReservationRequestByClientIdAndHostId(reviewerId, hostId)
+	requests, err := service.store.GetCompletedByClientIdAndHostId(reviewerId, hostId)
 	if err != nil {
 		return false
 	}
@@ -276,7 +636,7 @@ func (service *ReservationRequestService) CheckGuestHasReservationForHost(review
 
 func (service *ReservationRequestService) CheckGuestHasReservationForAccommodation(reviewerId string, accommodationId primitive.ObjectID, span trace.Span, loki promtail.Client) bool {
 	util.HttpTraceInfo("Fetching reservation requests by client id and accommodation id...", span, loki, "CheckGuestHasReservationForAccommodation", "")
-	requests, err := service.store.GetByClientIdAndAccommodationId(reviewerId, accommodationId)
+	requests, err := service.store.GetCompletedByClientIdAndAccommodationId(reviewerId, accommodationId)
 	if err != nil {
 		return false
 	}
@@ -289,10 +649,8 @@ func (service *ReservationRequestService) CheckAccommodationHasReservation(accom
 	if err != nil {
 		return false
 	}
-	for _, reservationRequest := range reservationRequests {
-		if isReservationInFuture(reservationRequest) {
-			return false
-		}
+	if service.hasActiveOrUpcomingReservation(reservationRequests, time.Now()) {
+		return false
 	}
 
 	for _, reservationRequest := range reservationRequests {
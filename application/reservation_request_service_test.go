@@ -0,0 +1,88 @@
+package application
+
+import (
+	"errors"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestApproveRequestConcurrentOverlappingStays(t *testing.T) {
+	const approvals = 8
+	accommodationId := primitive.NewObjectID()
+	unavailabilities := newFakeUnavailabilityStore(&domain.Unavailability{
+		Id:              primitive.NewObjectID(),
+		AccommodationId: accommodationId,
+		HostId:          "host",
+	})
+
+	checkIn := time.Now().AddDate(0, 1, 0).Truncate(24 * time.Hour)
+	var requests []*domain.ReservationRequest
+	for i := 0; i < approvals; i++ {
+		start := checkIn.AddDate(0, 0, i%3)
+		requests = append(requests, &domain.ReservationRequest{
+			Id:              primitive.NewObjectID(),
+			AccommodationId: accommodationId,
+			HostId:          "host",
+			UserId:          "guest",
+			Start:           start,
+			End:             start.AddDate(0, 0, 4),
+			NumberOfGuests:  2,
+			Status:          domain.Pending,
+		})
+	}
+	reservationRequests := newFakeReservationRequestStore(requests...)
+	outbox := &fakeOutboxStore{}
+	unavailabilityService := NewUnavailabilityService(unavailabilities, fakeTransactionManager{}, outbox, reservationRequests, nil, nil, noopLoki{})
+	service := NewReservationRequestService(reservationRequests, unavailabilityService, fakeTransactionManager{}, outbox, noopLoki{})
+
+	var wait sync.WaitGroup
+	errs := make([]error, approvals)
+	for i, request := range requests {
+		wait.Add(1)
+		go func(i int, id primitive.ObjectID) {
+			defer wait.Done()
+			errs[i] = service.approveRequest(id, false, noSpan, noopLoki{})
+		}(i, request.Id)
+	}
+	wait.Wait()
+
+	approved := 0
+	for i, err := range errs {
+		if err == nil {
+			approved++
+			continue
+		}
+		var conflictErr *domain.ReservationConflictError
+		if !errors.Is(err, domain.ErrConcurrentModification) && !errors.As(err, &conflictErr) && err.Error() != "reservation is not pending" {
+			t.Errorf("approval %d failed with %v, want a concurrent modification or a conflict", i, err)
+		}
+	}
+	if approved != 1 {
+		t.Fatalf("%d approvals succeeded, want 1", approved)
+	}
+
+	unavailability, _ := unavailabilities.GetByAccommodationId(accommodationId)
+	var reserved []domain.UnavailabilityPeriod
+	for _, period := range unavailability.UnavailabilityPeriods {
+		if period.Reason == domain.Reserved {
+			reserved = append(reserved, period)
+		}
+	}
+	if len(reserved) != 1 {
+		t.Fatalf("%d reserved periods, want 1", len(reserved))
+	}
+
+	for _, request := range requests {
+		stored, _ := reservationRequests.Get(request.Id)
+		want := domain.DeclinedByHost
+		if request.Id == reserved[0].ReservationId {
+			want = domain.Approved
+		}
+		if stored.Status != want {
+			t.Errorf("request %s has status %v, want %v", request.Id.Hex(), stored.Status, want)
+		}
+	}
+}
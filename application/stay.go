@@ -0,0 +1,139 @@
+package application
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"sync"
+	"time"
+)
+
+// Loaded time zones are kept by name; time.LoadLocation reads the zone database on every call.
+var locations sync.Map
+
+func getLocation(unavailability *domain.Unavailability) *time.Location {
+	if unavailability == nil || unavailability.TimeZone == "" {
+		return time.UTC
+	}
+	if location, ok := locations.Load(unavailability.TimeZone); ok {
+		return location.(*time.Location)
+	}
+	location, err := time.LoadLocation(unavailability.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	locations.Store(unavailability.TimeZone, location)
+	return location
+}
+
+func getCheckInTime(unavailability *domain.Unavailability) domain.TimeOfDay {
+	if unavailability == nil || unavailability.CheckInTime == nil {
+		return domain.DefaultCheckInTime
+	}
+	return *unavailability.CheckInTime
+}
+
+func getCheckOutTime(unavailability *domain.Unavailability) domain.TimeOfDay {
+	if unavailability == nil || unavailability.CheckOutTime == nil {
+		return domain.DefaultCheckOutTime
+	}
+	return *unavailability.CheckOutTime
+}
+
+// Timestamps coming from clients are read as the calendar date they carry in the offset they were sent with.
+func inputDate(t time.Time) domain.Date {
+	return domain.DateOf(t, t.Location())
+}
+
+func stayFromInput(start time.Time, end time.Time) domain.Stay {
+	return domain.Stay{CheckIn: inputDate(start), CheckOut: inputDate(end)}
+}
+
+// Stored periods and requests hold check-in and check-out instants, which map back to dates in the accommodation's zone.
+// A range within a single date still blocks that night.
+func storedStay(unavailability *domain.Unavailability, start time.Time, end time.Time) domain.Stay {
+	location := getLocation(unavailability)
+	stay := domain.Stay{CheckIn: domain.DateOf(start, location), CheckOut: domain.DateOf(end, location)}
+	if !stay.CheckOut.After(stay.CheckIn) {
+		stay.CheckOut = stay.CheckIn.AddDays(1)
+	}
+	return stay
+}
+
+func storedDate(unavailability *domain.Unavailability, t time.Time) domain.Date {
+	return domain.DateOf(t, getLocation(unavailability))
+}
+
+func getStayBounds(unavailability *domain.Unavailability, stay domain.Stay) (time.Time, time.Time) {
+	location := getLocation(unavailability)
+	return stay.CheckIn.At(getCheckInTime(unavailability), location), stay.CheckOut.At(getCheckOutTime(unavailability), location)
+}
+
+func getPeriodBounds(unavailability *domain.Unavailability, period domain.UnavailabilityPeriod) (time.Time, time.Time) {
+	return getStayBounds(unavailability, storedStay(unavailability, period.Start, period.End))
+}
+
+// Dates a host enters for overrides are stored as midnight in the accommodation's zone.
+func normalizeDateRange(unavailability *domain.Unavailability, start time.Time, end time.Time) (time.Time, time.Time) {
+	location := getLocation(unavailability)
+	return inputDate(start).At(domain.TimeOfDay{}, location), inputDate(end).At(domain.TimeOfDay{}, location)
+}
+
+func storedDateRangeContains(unavailability *domain.Unavailability, start time.Time, end time.Time, date domain.Date) bool {
+	return !date.Before(storedDate(unavailability, start)) && date.Before(storedDate(unavailability, end))
+}
+
+func today(unavailability *domain.Unavailability, now time.Time) domain.Date {
+	return domain.DateOf(now, getLocation(unavailability))
+}
+
+// Owner blocks entered for a single date close that night.
+func ownerStayFromInput(start time.Time, end time.Time) domain.Stay {
+	stay := stayFromInput(start, end)
+	if !stay.CheckOut.After(stay.CheckIn) {
+		stay.CheckOut = stay.CheckIn.AddDays(1)
+	}
+	return stay
+}
+
+// Splitting a block leaves pieces cut at arbitrary instants; they are put back on check-in and check-out times.
+func normalizeOwnerPeriods(unavailability *domain.Unavailability, periods []domain.UnavailabilityPeriod) []domain.UnavailabilityPeriod {
+	for i, period := range periods {
+		if period.Reason != domain.Reserved {
+			periods[i].Start, periods[i].End = getPeriodBounds(unavailability, period)
+		}
+	}
+	return periods
+}
+
+// moveStayPeriod keeps a period on the dates it had under the previous settings and shifts its turnover buffer along.
+func moveStayPeriod(previous *domain.Unavailability, updated *domain.Unavailability, period domain.UnavailabilityPeriod) domain.UnavailabilityPeriod {
+	moved := period
+	moved.Start, moved.End = getStayBounds(updated, storedStay(previous, period.Start, period.End))
+	if !period.BlockedStart.IsZero() {
+		moved.BlockedStart = moved.Start.Add(-period.Start.Sub(period.BlockedStart))
+	}
+	if !period.BlockedEnd.IsZero() {
+		moved.BlockedEnd = moved.End.Add(period.BlockedEnd.Sub(period.End))
+	}
+	return moved
+}
+
+func validateStaySettings(timeZone string, checkInTime domain.TimeOfDay, checkOutTime domain.TimeOfDay) error {
+	if timeZone == "" {
+		return &domain.ValidationError{Message: "time zone is required"}
+	}
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return &domain.ValidationError{Message: "unknown time zone " + timeZone}
+	}
+	if !isValidTimeOfDay(checkInTime) || !isValidTimeOfDay(checkOutTime) {
+		return &domain.ValidationError{Message: "check-in and check-out times must be between 00:00 and 23:59"}
+	}
+	// Otherwise a guest leaving on the day another arrives would overlap with them.
+	if checkOutTime.Hour*60+checkOutTime.Minute > checkInTime.Hour*60+checkInTime.Minute {
+		return &domain.ValidationError{Message: "check-out time cannot be later than check-in time"}
+	}
+	return nil
+}
+
+func isValidTimeOfDay(timeOfDay domain.TimeOfDay) bool {
+	return timeOfDay.Hour >= 0 && timeOfDay.Hour < 24 && timeOfDay.Minute >= 0 && timeOfDay.Minute < 60
+}
@@ -0,0 +1,79 @@
+package application
+
+import (
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"time"
+)
+
+func validateStayRules(rules domain.StayRules) error {
+	if err := validateStayLimits(rules.MinNights, rules.MaxNights, rules.CheckInDays); err != nil {
+		return err
+	}
+	for _, override := range rules.Overrides {
+		if !override.Start.Before(override.End) {
+			return &domain.ValidationError{Message: "stay rule override start must be before its end"}
+		}
+		if err := validateStayLimits(override.MinNights, override.MaxNights, override.CheckInDays); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateStayLimits(minNights int, maxNights int, checkInDays []time.Weekday) error {
+	if minNights < 0 || maxNights < 0 {
+		return &domain.ValidationError{Message: "stay length limits cannot be negative"}
+	}
+	if maxNights > 0 && minNights > maxNights {
+		return &domain.ValidationError{Message: "minimum nights cannot exceed maximum nights"}
+	}
+	for _, day := range checkInDays {
+		if day < time.Sunday || day > time.Saturday {
+			return &domain.ValidationError{Message: "invalid check-in day"}
+		}
+	}
+	return nil
+}
+
+// The rule in force is picked by the check-in date: the first override covering it, otherwise the defaults.
+func getStayRule(unavailability *domain.Unavailability, checkIn domain.Date) domain.StayRuleOverride {
+	rules := unavailability.StayRules
+	for _, override := range rules.Overrides {
+		if storedDateRangeContains(unavailability, override.Start, override.End, checkIn) {
+			return override
+		}
+	}
+	return domain.StayRuleOverride{
+		MinNights:   rules.MinNights,
+		MaxNights:   rules.MaxNights,
+		CheckInDays: rules.CheckInDays,
+	}
+}
+
+func checkStayRules(unavailability *domain.Unavailability, stay domain.Stay) error {
+	rule := getStayRule(unavailability, stay.CheckIn)
+	nights := stay.Nights()
+	if rule.MinNights > 0 && nights < rule.MinNights {
+		return &domain.ValidationError{Message: fmt.Sprintf("stay must be at least %d nights", rule.MinNights)}
+	}
+	if rule.MaxNights > 0 && nights > rule.MaxNights {
+		return &domain.ValidationError{Message: fmt.Sprintf("stay cannot be longer than %d nights", rule.MaxNights)}
+	}
+	if !isCheckInDayAllowed(rule, stay.CheckIn) {
+		return &domain.ValidationError{Message: fmt.Sprintf("check-in is not allowed on %s", stay.CheckIn.Weekday())}
+	}
+	return nil
+}
+
+func isCheckInDayAllowed(rule domain.StayRuleOverride, checkIn domain.Date) bool {
+	if len(rule.CheckInDays) == 0 {
+		return true
+	}
+	for _, day := range rule.CheckInDays {
+		if checkIn.Weekday() == day {
+			return true
+		}
+	}
+	return false
+}
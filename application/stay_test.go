@@ -0,0 +1,24 @@
+package application
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"testing"
+	"time"
+)
+
+func TestStayPrefilterWindowHoldsStayBoundsInEveryZone(t *testing.T) {
+	checkIn := domain.DateOf(time.Date(2026, time.March, 28, 0, 0, 0, 0, time.UTC), time.UTC)
+	stay := domain.Stay{CheckIn: checkIn, CheckOut: checkIn.AddDays(2)}
+	windowStart, windowEnd := stay.PrefilterWindow()
+
+	for _, timeZone := range []string{"Pacific/Kiritimati", "Etc/GMT+12", "Europe/Belgrade"} {
+		for _, timeOfDay := range []domain.TimeOfDay{{}, {Hour: 23, Minute: 59}} {
+			unavailability := &domain.Unavailability{TimeZone: timeZone, CheckInTime: &timeOfDay, CheckOutTime: &timeOfDay}
+			start, end := getStayBounds(unavailability, stay)
+			start, end = start.Add(-domain.MaxTurnoverBuffer), end.Add(domain.MaxTurnoverBuffer)
+			if start.Before(windowStart) || end.After(windowEnd) {
+				t.Errorf("buffered stay %s to %s in %s is outside the window %s to %s", start, end, timeZone, windowStart, windowEnd)
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package application
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"time"
+)
+
+func validateTurnoverBuffer(buffer domain.TurnoverBuffer) error {
+	if buffer.Before < 0 || buffer.After < 0 {
+		return &domain.ValidationError{Message: "turnover buffer cannot be negative"}
+	}
+	if buffer.Before > domain.MaxTurnoverBuffer || buffer.After > domain.MaxTurnoverBuffer {
+		return &domain.ValidationError{Message: "turnover buffer cannot be longer than a week"}
+	}
+	return nil
+}
+
+// The buffer in force when a reservation is approved is stored on its period, so later changes do not move existing stays.
+func applyTurnoverBuffer(period *domain.UnavailabilityPeriod, buffer domain.TurnoverBuffer) {
+	period.BlockedStart = period.Start.Add(-buffer.Before)
+	period.BlockedEnd = period.End.Add(buffer.After)
+}
+
+// The stored buffer is kept as an offset, so the blocked range follows the period's normalized check-in and check-out.
+func getBlockedRange(unavailability *domain.Unavailability, period domain.UnavailabilityPeriod) (time.Time, time.Time) {
+	start, end := getPeriodBounds(unavailability, period)
+	if !period.BlockedStart.IsZero() {
+		start = start.Add(-period.Start.Sub(period.BlockedStart))
+	}
+	if !period.BlockedEnd.IsZero() {
+		end = end.Add(period.BlockedEnd.Sub(period.End))
+	}
+	return start, end
+}
+
+// getPendingConflictRange returns the range a pending stay must keep clear of to remain approvable next to a reservation
+// period: neither the reservation's buffer nor the stay's own may reach into the other.
+func getPendingConflictRange(period *domain.UnavailabilityPeriod, buffer domain.TurnoverBuffer) (time.Time, time.Time) {
+	widest := max(buffer.Before, buffer.After)
+	return period.Start.Add(-widest), period.End.Add(widest)
+}
+
+func findStayConflicts(unavailability *domain.Unavailability, stay domain.Stay) []domain.UnavailabilityPeriod {
+	start, end := getStayBounds(unavailability, stay)
+	return findReservationConflicts(unavailability, start, end)
+}
+
+// findReservationConflicts returns the periods a stay between the given check-in and check-out instants would collide
+// with. Besides plain overlap, a stay may neither fall into the buffer around an existing reservation nor push its own
+// buffer into one.
+func findReservationConflicts(unavailability *domain.Unavailability, start time.Time, end time.Time) []domain.UnavailabilityPeriod {
+	unavailability = withOccurrencesAround(unavailability, start, end)
+	buffer := unavailability.TurnoverBuffer
+	var conflictingPeriods []domain.UnavailabilityPeriod
+	for _, period := range unavailability.UnavailabilityPeriods {
+		periodStart, periodEnd := getPeriodBounds(unavailability, period)
+		if periodsOverlap(start, end, periodStart, periodEnd) || period.Reason == domain.Reserved && isWithinTurnoverBuffer(unavailability, period, buffer, start, end) {
+			conflictingPeriods = append(conflictingPeriods, period)
+		}
+	}
+	return conflictingPeriods
+}
+
+func isWithinTurnoverBuffer(unavailability *domain.Unavailability, period domain.UnavailabilityPeriod, buffer domain.TurnoverBuffer, start time.Time, end time.Time) bool {
+	periodStart, periodEnd := getPeriodBounds(unavailability, period)
+	blockedStart, blockedEnd := getBlockedRange(unavailability, period)
+	return periodsOverlap(start, end, blockedStart, blockedEnd) ||
+		periodsOverlap(start.Add(-buffer.Before), end.Add(buffer.After), periodStart, periodEnd)
+}
@@ -1,37 +1,46 @@
 package application
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/ZMS-DevOps/booking-service/domain"
 	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
 	"github.com/ZMS-DevOps/booking-service/util"
 	"github.com/afiskon/promtail-client/promtail"
-	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.opentelemetry.io/otel/trace"
 	"log"
 	"time"
 )
 
+const (
+	maxUnavailabilityUpdateAttempts = 5
+	unavailabilityUpdateBackoff     = 20 * time.Millisecond
+)
+
 type UnavailabilityService struct {
 	store                   domain.UnavailabilityStore
+	tombstones              domain.AccommodationTombstoneStore
 	reservationRequestStore domain.ReservationRequestStore
-	producer                *kafka.Producer
+	calendarFetcher         domain.CalendarFeedFetcher
+	transactions            domain.TransactionManager
+	outbox                  domain.OutboxStore
 	loki                    promtail.Client
 }
 
-func NewUnavailabilityService(store domain.UnavailabilityStore, producer *kafka.Producer, reservationRequestStore domain.ReservationRequestStore, loki promtail.Client) *UnavailabilityService {
+func NewUnavailabilityService(store domain.UnavailabilityStore, transactions domain.TransactionManager, outbox domain.OutboxStore, reservationRequestStore domain.ReservationRequestStore, calendarFetcher domain.CalendarFeedFetcher, tombstones domain.AccommodationTombstoneStore, loki promtail.Client) *UnavailabilityService {
 	return &UnavailabilityService{
 		store:                   store,
-		producer:                producer,
+		tombstones:              tombstones,
+		transactions:            transactions,
+		outbox:                  outbox,
 		reservationRequestStore: reservationRequestStore,
+		calendarFetcher:         calendarFetcher,
 		loki:                    loki,
 	}
 }
 
-func (service *UnavailabilityService) AddUnavailability(accommodationId primitive.ObjectID, accommodationName string, automatically bool, hostId string, span trace.Span, loki promtail.Client) error {
+func (service *UnavailabilityService) AddUnavailability(accommodationId primitive.ObjectID, accommodationName string, automatically bool, hostId string, responseWindow time.Duration, span trace.Span, loki promtail.Client) error {
 	util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "AddUnavailability", "")
 	unavailability, err := service.store.GetByAccommodationId(accommodationId)
 	if err != nil {
@@ -43,13 +52,11 @@ func (service *UnavailabilityService) AddUnavailability(accommodationId primitiv
 	}
 
 	newUnavailability := &domain.Unavailability{
-		Id:                                    primitive.NewObjectID(),
-		AccommodationId:                       accommodationId,
-		AccommodationName:                     accommodationName,
-		UnavailabilityPeriods:                 []domain.UnavailabilityPeriod{},
-		HostId:                                hostId,
-		ReviewReservationRequestAutomatically: automatically,
+		Id:                    primitive.NewObjectID(),
+		AccommodationId:       accommodationId,
+		UnavailabilityPeriods: []domain.UnavailabilityPeriod{},
 	}
+	setAccommodationDetails(newUnavailability, accommodationName, automatically, hostId, responseWindow)
 
 	util.HttpTraceInfo("Add unavailability...", span, loki, "AddUnavailability", "")
 	if err := service.store.Insert(newUnavailability); err != nil {
@@ -59,79 +66,545 @@ func (service *UnavailabilityService) AddUnavailability(accommodationId primitiv
 	return nil
 }
 
-func (service *UnavailabilityService) UpdateUnavailability(accommodationId primitive.ObjectID, accommodationName string, automatically bool, hostId string, span trace.Span, loki promtail.Client) error {
-	util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "UpdateUnavailability", "")
-	unavailability, err := service.store.GetByAccommodationId(accommodationId)
+func (service *UnavailabilityService) UpdateUnavailability(accommodationId primitive.ObjectID, accommodationName string, automatically bool, hostId string, responseWindow time.Duration, span trace.Span, loki promtail.Client) error {
+	return retryOnConcurrentModification(func() error {
+		util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "UpdateUnavailability", "")
+		unavailability, err := service.store.GetByAccommodationId(accommodationId)
+		if err != nil {
+			return err
+		}
+		if unavailability == nil {
+			return domain.ErrAccommodationNotFound
+		}
+
+		setAccommodationDetails(unavailability, accommodationName, automatically, hostId, responseWindow)
+
+		util.HttpTraceInfo("Updating unavailability...", span, loki, "UpdateUnavailability", "")
+		return service.store.Update(unavailability.Id, unavailability)
+	})
+}
+
+// upsertAccommodation applies a created or updated accommodation, whichever of them arrives first. Events from before
+// the stored details or the accommodation's deletion arrived late and are ignored.
+func (service *UnavailabilityService) upsertAccommodation(transaction domain.Transaction, accommodationId primitive.ObjectID, accommodationName string, automatically bool, hostId string, responseWindow time.Duration, updatedAt time.Time, span trace.Span, loki promtail.Client) error {
+	util.HttpTraceInfo("Fetching accommodation tombstone...", span, loki, "upsertAccommodation", "")
+	tombstone, err := service.tombstones.WithContext(transaction.Context()).Get(accommodationId)
 	if err != nil {
 		return err
 	}
+	if tombstone != nil && !updatedAt.After(tombstone.DeletedAt) {
+		util.HttpTraceInfo("Ignoring event of a deleted accommodation", span, loki, "upsertAccommodation", accommodationId.Hex())
+		return nil
+	}
 
-	unavailability.ReviewReservationRequestAutomatically = automatically
+	store := service.store.WithContext(transaction.Context())
+	util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "upsertAccommodation", "")
+	previous, err := store.GetByAccommodationId(accommodationId)
+	if err != nil {
+		return err
+	}
+	if previous != nil && !previous.SourceUpdatedAt.Before(updatedAt) {
+		util.HttpTraceInfo("Ignoring event older than the stored accommodation", span, loki, "upsertAccommodation", accommodationId.Hex())
+		return nil
+	}
+
+	unavailability := &domain.Unavailability{AccommodationId: accommodationId, SourceUpdatedAt: updatedAt}
+	setAccommodationDetails(unavailability, accommodationName, automatically, hostId, responseWindow)
+	util.HttpTraceInfo("Upserting unavailability...", span, loki, "upsertAccommodation", "")
+	if err := store.UpsertAccommodation(unavailability); err != nil {
+		return err
+	}
+	transaction.Compensate(func() error {
+		if previous == nil {
+			return service.store.DeleteByAccommodationId(accommodationId)
+		}
+		restored := *previous
+		restored.Version++
+		return service.store.Update(restored.Id, &restored)
+	})
+	return nil
+}
+
+// deleteAccommodation keeps the accommodation's reservation requests as booking history. The tombstone it leaves
+// keeps late created and updated events from recreating the accommodation.
+func (service *UnavailabilityService) deleteAccommodation(transaction domain.Transaction, accommodationId primitive.ObjectID, deletedAt time.Time, span trace.Span, loki promtail.Client) error {
+	tombstones := service.tombstones.WithContext(transaction.Context())
+	tombstone, err := tombstones.Get(accommodationId)
+	if err != nil {
+		return err
+	}
+	util.HttpTraceInfo("Recording accommodation tombstone...", span, loki, "deleteAccommodation", "")
+	if err := tombstones.Upsert(&domain.AccommodationTombstone{AccommodationId: accommodationId, DeletedAt: deletedAt}); err != nil {
+		return err
+	}
+	if tombstone == nil {
+		transaction.Compensate(func() error {
+			return service.tombstones.Delete(accommodationId)
+		})
+	}
+
+	util.HttpTraceInfo("Deleting unavailability by accommodation id...", span, loki, "deleteAccommodation", "")
+	return service.store.WithContext(transaction.Context()).DeleteByAccommodationId(accommodationId)
+}
+
+func setAccommodationDetails(unavailability *domain.Unavailability, accommodationName string, automatically bool, hostId string, responseWindow time.Duration) {
 	unavailability.AccommodationName = accommodationName
 	unavailability.HostId = hostId
+	unavailability.ReviewReservationRequestAutomatically = automatically
+	unavailability.ReservationRequestResponseWindow = getResponseWindow(responseWindow)
+}
 
-	util.HttpTraceInfo("Updating unavailability...", span, loki, "UpdateUnavailability", "")
-	if err := service.store.Update(unavailability.Id, unavailability); err != nil {
+func (service *UnavailabilityService) UpdateCancellationPolicy(accommodationId primitive.ObjectID, policy domain.CancellationPolicy, span trace.Span, loki promtail.Client) error {
+	if err := validateCancellationPolicy(policy); err != nil {
 		return err
 	}
+	if policy.Type != domain.CustomCancellationPolicy {
+		policy.Tiers = nil
+	}
 
-	return nil
+	return retryOnConcurrentModification(func() error {
+		util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "UpdateCancellationPolicy", "")
+		unavailability, err := service.store.GetByAccommodationId(accommodationId)
+		if err != nil {
+			return err
+		}
+		if unavailability == nil {
+			return domain.ErrAccommodationNotFound
+		}
+
+		unavailability.CancellationPolicy = policy
+
+		util.HttpTraceInfo("Updating cancellation policy...", span, loki, "UpdateCancellationPolicy", "")
+		return service.store.Update(unavailability.Id, unavailability)
+	})
 }
 
-func (service *UnavailabilityService) AddUnavailabilityPeriod(accommodationId primitive.ObjectID, period *domain.UnavailabilityPeriod, span trace.Span, loki promtail.Client) error {
-	util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "AddUnavailabilityPeriod", "")
-	var unavailability, err = service.store.GetByAccommodationId(accommodationId)
+func (service *UnavailabilityService) UpdatePricing(accommodationId primitive.ObjectID, pricing domain.Pricing, span trace.Span, loki promtail.Client) error {
+	if err := validatePricing(pricing); err != nil {
+		return err
+	}
+
+	return retryOnConcurrentModification(func() error {
+		util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "UpdatePricing", "")
+		unavailability, err := service.store.GetByAccommodationId(accommodationId)
+		if err != nil {
+			return err
+		}
+		if unavailability == nil {
+			return domain.ErrAccommodationNotFound
+		}
+
+		unavailability.Pricing = pricing
+		unavailability.Pricing.Overrides = nil
+		for _, override := range pricing.Overrides {
+			override.Start, override.End = normalizeDateRange(unavailability, override.Start, override.End)
+			unavailability.Pricing.Overrides = append(unavailability.Pricing.Overrides, override)
+		}
+
+		util.HttpTraceInfo("Updating pricing...", span, loki, "UpdatePricing", "")
+		return service.store.Update(unavailability.Id, unavailability)
+	})
+}
+
+func (service *UnavailabilityService) GetPricing(accommodationId primitive.ObjectID, span trace.Span, loki promtail.Client) (*domain.Pricing, error) {
+	util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "GetPricing", "")
+	unavailability, err := service.store.GetByAccommodationId(accommodationId)
+	if err != nil {
+		return nil, err
+	}
+	if unavailability == nil {
+		return nil, domain.ErrAccommodationNotFound
+	}
+
+	pricing, err := getPricing(unavailability)
+	if err != nil {
+		return nil, err
+	}
+	return &pricing, nil
+}
+
+func (service *UnavailabilityService) GetPriceQuote(accommodationId primitive.ObjectID, start time.Time, end time.Time, numberOfGuests int, span trace.Span, loki promtail.Client) (*domain.PriceQuote, error) {
+	util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "GetPriceQuote", "")
+	unavailability, err := service.store.GetByAccommodationId(accommodationId)
 	if err != nil {
+		return nil, err
+	}
+	if unavailability == nil {
+		return nil, domain.ErrAccommodationNotFound
+	}
+
+	util.HttpTraceInfo("Calculating price quote...", span, loki, "GetPriceQuote", "")
+	return calculatePriceQuote(unavailability, stayFromInput(start, end), numberOfGuests)
+}
+
+func (service *UnavailabilityService) UpdateStayRules(accommodationId primitive.ObjectID, rules domain.StayRules, span trace.Span, loki promtail.Client) error {
+	if err := validateStayRules(rules); err != nil {
 		return err
 	}
-	period.Id = primitive.NewObjectID()
 
-	if !(service.checkIfCouldAddUnavailability(unavailability, period, span)) {
-		return errors.New("could not add unavailability period")
+	return retryOnConcurrentModification(func() error {
+		util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "UpdateStayRules", "")
+		unavailability, err := service.store.GetByAccommodationId(accommodationId)
+		if err != nil {
+			return err
+		}
+		if unavailability == nil {
+			return domain.ErrAccommodationNotFound
+		}
+
+		unavailability.StayRules = rules
+		unavailability.StayRules.Overrides = nil
+		for _, override := range rules.Overrides {
+			override.Start, override.End = normalizeDateRange(unavailability, override.Start, override.End)
+			unavailability.StayRules.Overrides = append(unavailability.StayRules.Overrides, override)
+		}
+
+		util.HttpTraceInfo("Updating stay rules...", span, loki, "UpdateStayRules", "")
+		return service.store.Update(unavailability.Id, unavailability)
+	})
+}
+
+func (service *UnavailabilityService) UpdateTurnoverBuffer(accommodationId primitive.ObjectID, buffer domain.TurnoverBuffer, span trace.Span, loki promtail.Client) error {
+	if err := validateTurnoverBuffer(buffer); err != nil {
+		return err
 	}
 
-	util.HttpTraceInfo("Updating unavailability periods...", span, loki, "AddUnavailabilityPeriod", "")
-	if err := service.store.UpdateUnavailabilityPeriods(unavailability.Id, insertPeriod(period, unavailability.UnavailabilityPeriods)); err != nil {
+	return retryOnConcurrentModification(func() error {
+		util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "UpdateTurnoverBuffer", "")
+		unavailability, err := service.store.GetByAccommodationId(accommodationId)
+		if err != nil {
+			return err
+		}
+		if unavailability == nil {
+			return domain.ErrAccommodationNotFound
+		}
+
+		unavailability.TurnoverBuffer = buffer
+
+		util.HttpTraceInfo("Updating turnover buffer...", span, loki, "UpdateTurnoverBuffer", "")
+		return service.store.Update(unavailability.Id, unavailability)
+	})
+}
+
+// Changing the zone or the check-in and check-out times keeps every stored stay on the same dates and moves its
+// instants, together with those of the accommodation's open requests, to the new times.
+func (service *UnavailabilityService) UpdateStaySettings(accommodationId primitive.ObjectID, timeZone string, checkInTime domain.TimeOfDay, checkOutTime domain.TimeOfDay, span trace.Span, loki promtail.Client) error {
+	if err := validateStaySettings(timeZone, checkInTime, checkOutTime); err != nil {
 		return err
 	}
 
-	if err := service.reservationRequestStore.DeleteByAccommodation(accommodationId); err != nil {
+	return retryOnConcurrentModification(func() error {
+		return service.transactions.WithTransaction(func(transaction domain.Transaction) error {
+			return service.updateStaySettings(transaction, accommodationId, timeZone, checkInTime, checkOutTime, span, loki)
+		})
+	})
+}
+
+// The open requests are moved in the transaction that saves the settings, so their dates never disagree with the
+// periods reserved for them.
+func (service *UnavailabilityService) updateStaySettings(transaction domain.Transaction, accommodationId primitive.ObjectID, timeZone string, checkInTime domain.TimeOfDay, checkOutTime domain.TimeOfDay, span trace.Span, loki promtail.Client) error {
+	store := service.store.WithContext(transaction.Context())
+	requestStore := service.reservationRequestStore.WithContext(transaction.Context())
+
+	util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "UpdateStaySettings", "")
+	unavailability, err := store.GetByAccommodationId(accommodationId)
+	if err != nil {
 		return err
 	}
+	if unavailability == nil {
+		return domain.ErrAccommodationNotFound
+	}
+
+	previous := *unavailability
+	unavailability.TimeZone = timeZone
+	unavailability.CheckInTime = &checkInTime
+	unavailability.CheckOutTime = &checkOutTime
+	unavailability.UnavailabilityPeriods = nil
+	for _, period := range previous.UnavailabilityPeriods {
+		unavailability.UnavailabilityPeriods = append(unavailability.UnavailabilityPeriods, moveStayPeriod(&previous, unavailability, period))
+	}
 
+	util.HttpTraceInfo("Updating stay settings...", span, loki, "UpdateStaySettings", "")
+	if err := store.Update(unavailability.Id, unavailability); err != nil {
+		return err
+	}
+	transaction.Compensate(func() error {
+		restored := previous
+		restored.Version++
+		return service.store.Update(restored.Id, &restored)
+	})
+
+	util.HttpTraceInfo("Moving open reservation requests...", span, loki, "UpdateStaySettings", "")
+	requests, err := requestStore.GetByAccommodationId(accommodationId)
+	if err != nil {
+		return err
+	}
+	for _, request := range requests {
+		if request.Status != domain.Pending && request.Status != domain.Approved {
+			continue
+		}
+		start, end := getStayBounds(unavailability, storedStay(&previous, request.Start, request.End))
+		if err := requestStore.UpdateDates(request.Id, start, end); err != nil {
+			return err
+		}
+		transaction.Compensate(func() error {
+			return service.reservationRequestStore.UpdateDates(request.Id, request.Start, request.End)
+		})
+	}
 	return nil
 }
 
-func (service *UnavailabilityService) checkIfCouldAddUnavailability(unavailability *domain.Unavailability, period *domain.UnavailabilityPeriod, span trace.Span) bool {
-	for _, unavailabilityPeriod := range unavailability.UnavailabilityPeriods {
-		if periodsOverlap(unavailabilityPeriod.Start, unavailabilityPeriod.End, period.Start, period.End) {
-			if unavailabilityPeriod.Reason == domain.Reserved {
-				return false
+func (service *UnavailabilityService) AddUnavailabilityPeriod(accommodationId primitive.ObjectID, period *domain.UnavailabilityPeriod, span trace.Span, loki promtail.Client) error {
+	period.Id = primitive.NewObjectID()
+	stay := ownerStayFromInput(period.Start, period.End)
+	return retryOnConcurrentModification(func() error {
+		return service.transactions.WithTransaction(func(transaction domain.Transaction) error {
+			store := service.store.WithContext(transaction.Context())
+			util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "AddUnavailabilityPeriod", "")
+			unavailability, err := store.GetByAccommodationId(accommodationId)
+			if err != nil {
+				return err
+			}
+			if unavailability == nil {
+				return domain.ErrAccommodationNotFound
 			}
 
+			period.Start, period.End = getStayBounds(unavailability, stay)
+			if conflictingPeriods := service.getBlockingPeriods(unavailability, period); len(conflictingPeriods) > 0 {
+				return &domain.ReservationConflictError{
+					AccommodationId:    accommodationId,
+					ConflictingPeriods: conflictingPeriods,
+				}
+			}
+
+			util.HttpTraceInfo("Updating unavailability periods...", span, loki, "AddUnavailabilityPeriod", "")
+			if err := store.UpdateUnavailabilityPeriods(unavailability.Id, unavailability.Version, insertPeriod(period, copyPeriods(unavailability.UnavailabilityPeriods))); err != nil {
+				return err
+			}
+			service.compensatePeriods(transaction, unavailability)
+
+			host := dto.HostActor(unavailability.HostId)
+			if err := enqueueAvailabilityEvent(transaction, service.outbox, span, dto.AvailabilityEvent{
+				Type:            dto.PeriodBlocked,
+				Actor:           host,
+				AccommodationId: accommodationId,
+				HostId:          unavailability.HostId,
+				Period:          *period,
+				Stay:            stay,
+			}); err != nil {
+				return err
+			}
+			return service.declineOverlappingPendingRequests(transaction, unavailability, period, host, span, loki)
+		})
+	})
+}
+
+// declineOverlappingPendingRequests declines the pending requests a new block leaves no room for. Requests for other
+// dates stay pending.
+func (service *UnavailabilityService) declineOverlappingPendingRequests(transaction domain.Transaction, unavailability *domain.Unavailability, period *domain.UnavailabilityPeriod, actor dto.EventActor, span trace.Span, loki promtail.Client) error {
+	store := service.reservationRequestStore.WithContext(transaction.Context())
+	conflictStart, conflictEnd := getPendingConflictRange(period, unavailability.TurnoverBuffer)
+
+	util.HttpTraceInfo("Declining overlapping pending requests...", span, loki, "AddUnavailabilityPeriod", "")
+	declinedIds, err := store.CancelOverlappingPendingRequests(&domain.ReservationRequest{AccommodationId: unavailability.AccommodationId}, conflictStart, conflictEnd)
+	if err != nil {
+		return err
+	}
+	transaction.Compensate(func() error {
+		return service.reservationRequestStore.RestorePendingRequests(declinedIds)
+	})
+
+	for _, declinedId := range declinedIds {
+		declinedRequest, err := store.Get(declinedId)
+		if err != nil {
+			return err
+		}
+		if err := enqueueReservationEvent(transaction, service.outbox, span, dto.ReservationEvent{
+			Type:        dto.ReservationDeclined,
+			Actor:       actor,
+			Reservation: declinedRequest,
+			Stay:        storedStay(unavailability, declinedRequest.Start, declinedRequest.End),
+		}); err != nil {
+			return err
 		}
 	}
-	return true
+	return nil
 }
 
-func (service *UnavailabilityService) RemoveUnavailabilityPeriod(accommodationId primitive.ObjectID, period *domain.UnavailabilityPeriod, shouldRemainReserved bool, span trace.Span, loki promtail.Client) error {
-	util.HttpTraceInfo("Removing unavailability period...", span, loki, "RemoveUnavailabilityPeriod", "")
-	unavailability, err := service.store.GetByAccommodationId(accommodationId)
-	log.Printf("unavailability %v removed from unavailability period %v\n", unavailability, period)
+// addReservedPeriod blocks the stay of an approved request. It returns the range other pending stays now conflict with.
+func (service *UnavailabilityService) addReservedPeriod(transaction domain.Transaction, reservationRequest *domain.ReservationRequest, actor dto.EventActor, span trace.Span, loki promtail.Client) (time.Time, time.Time, error) {
+	store := service.store.WithContext(transaction.Context())
+	period := &domain.UnavailabilityPeriod{
+		Id:            primitive.NewObjectID(),
+		Start:         reservationRequest.Start,
+		End:           reservationRequest.End,
+		Reason:        domain.Reserved,
+		ReservationId: reservationRequest.Id,
+	}
+
+	util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "addReservedPeriod", "")
+	unavailability, err := store.GetByAccommodationId(reservationRequest.AccommodationId)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if unavailability == nil {
+		return time.Time{}, time.Time{}, domain.ErrAccommodationNotFound
+	}
+
+	stay := storedStay(unavailability, reservationRequest.Start, reservationRequest.End)
+	period.Start, period.End = getStayBounds(unavailability, stay)
+	buffer := unavailability.TurnoverBuffer
+	applyTurnoverBuffer(period, buffer)
+	if conflictingPeriods := service.getBlockingPeriods(unavailability, period); len(conflictingPeriods) > 0 {
+		return time.Time{}, time.Time{}, &domain.ReservationConflictError{
+			AccommodationId:    reservationRequest.AccommodationId,
+			ConflictingPeriods: conflictingPeriods,
+		}
+	}
+
+	util.HttpTraceInfo("Updating unavailability periods...", span, loki, "addReservedPeriod", "")
+	updatedPeriods := insertPeriod(period, copyPeriods(unavailability.UnavailabilityPeriods))
+	if err := store.UpdateUnavailabilityPeriods(unavailability.Id, unavailability.Version, updatedPeriods); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	transaction.Compensate(func() error {
+		return service.updatePeriods(reservationRequest.AccommodationId, func(periods []domain.UnavailabilityPeriod) []domain.UnavailabilityPeriod {
+			remaining, _ := removeReservationPeriods(reservationRequest, periods)
+			return remaining
+		})
+	})
+	err = enqueueAvailabilityEvent(transaction, service.outbox, span, dto.AvailabilityEvent{
+		Type:            dto.PeriodBlocked,
+		Actor:           actor,
+		AccommodationId: reservationRequest.AccommodationId,
+		HostId:          reservationRequest.HostId,
+		Period:          *period,
+		Stay:            stay,
+	})
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	conflictStart, conflictEnd := getPendingConflictRange(period, buffer)
+	return conflictStart, conflictEnd, nil
+}
+
+func (service *UnavailabilityService) removeReservedPeriod(transaction domain.Transaction, reservationRequest *domain.ReservationRequest, actor dto.EventActor, span trace.Span, loki promtail.Client) error {
+	store := service.store.WithContext(transaction.Context())
+
+	util.HttpTraceInfo("Fetching unavailability by accommodation id...", span, loki, "removeReservedPeriod", "")
+	unavailability, err := store.GetByAccommodationId(reservationRequest.AccommodationId)
 	if err != nil {
 		return err
 	}
+	if unavailability == nil {
+		return domain.ErrAccommodationNotFound
+	}
 
-	updatedPeriods := removePeriod(*period, unavailability.UnavailabilityPeriods, shouldRemainReserved)
-	log.Printf("unavailability period %v removed from unavailability periods %v\n", updatedPeriods, period)
-	if err := service.store.UpdateUnavailabilityPeriods(unavailability.Id, updatedPeriods); err != nil {
+	util.HttpTraceInfo("Removing unavailability period...", span, loki, "removeReservedPeriod", reservationRequest.Id.Hex())
+	updatedPeriods, removedPeriods := removeReservationPeriods(reservationRequest, unavailability.UnavailabilityPeriods)
+	if len(removedPeriods) == 0 {
+		log.Printf("no reserved period found for reservation %s", reservationRequest.Id.Hex())
+		return nil
+	}
+	if err := store.UpdateUnavailabilityPeriods(unavailability.Id, unavailability.Version, updatedPeriods); err != nil {
 		return err
 	}
-	log.Printf("vrating period removed from unavailability periods %v\n", updatedPeriods)
 
+	transaction.Compensate(func() error {
+		return service.updatePeriods(reservationRequest.AccommodationId, func(periods []domain.UnavailabilityPeriod) []domain.UnavailabilityPeriod {
+			return mergeOverlappingPeriods(append(periods, removedPeriods...))
+		})
+	})
+	for _, removedPeriod := range removedPeriods {
+		err := enqueueAvailabilityEvent(transaction, service.outbox, span, dto.AvailabilityEvent{
+			Type:            dto.PeriodUnblocked,
+			Actor:           actor,
+			AccommodationId: reservationRequest.AccommodationId,
+			HostId:          reservationRequest.HostId,
+			Period:          removedPeriod,
+			Stay:            storedStay(unavailability, removedPeriod.Start, removedPeriod.End),
+		})
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// compensatePeriods restores the periods read before this transaction's single update, unless they have changed since.
+func (service *UnavailabilityService) compensatePeriods(transaction domain.Transaction, unavailability *domain.Unavailability) {
+	transaction.Compensate(func() error {
+		return service.store.UpdateUnavailabilityPeriods(unavailability.Id, unavailability.Version+1, unavailability.UnavailabilityPeriods)
+	})
+}
+
+func (service *UnavailabilityService) updatePeriods(accommodationId primitive.ObjectID, update func(periods []domain.UnavailabilityPeriod) []domain.UnavailabilityPeriod) error {
+	return retryOnConcurrentModification(func() error {
+		unavailability, err := service.store.GetByAccommodationId(accommodationId)
+		if err != nil {
+			return err
+		}
+		if unavailability == nil {
+			return domain.ErrAccommodationNotFound
+		}
+		return service.store.UpdateUnavailabilityPeriods(unavailability.Id, unavailability.Version, update(unavailability.UnavailabilityPeriods))
+	})
+}
+
+func (service *UnavailabilityService) getBlockingPeriods(unavailability *domain.Unavailability, period *domain.UnavailabilityPeriod) []domain.UnavailabilityPeriod {
+	if period.Reason == domain.Reserved {
+		return findReservationConflicts(unavailability, period.Start, period.End)
+	}
+	return getReservedPeriods(findConflictingPeriods(unavailability, period.Start, period.End))
+}
+
+func (service *UnavailabilityService) RemoveUnavailabilityPeriod(accommodationId primitive.ObjectID, period *domain.UnavailabilityPeriod, span trace.Span, loki promtail.Client) error {
+	stay := ownerStayFromInput(period.Start, period.End)
+	return retryOnConcurrentModification(func() error {
+		return service.transactions.WithTransaction(func(transaction domain.Transaction) error {
+			store := service.store.WithContext(transaction.Context())
+			util.HttpTraceInfo("Removing unavailability period...", span, loki, "RemoveUnavailabilityPeriod", "")
+			unavailability, err := store.GetByAccommodationId(accommodationId)
+			if err != nil {
+				return err
+			}
+			if unavailability == nil {
+				return domain.ErrAccommodationNotFound
+			}
+
+			toRemove := *period
+			toRemove.Start, toRemove.End = getStayBounds(unavailability, stay)
+			unblocked := hasOwnerPeriodWithin(unavailability, toRemove.Start, toRemove.End)
+			updatedPeriods := normalizeOwnerPeriods(unavailability, removePeriod(toRemove, unavailability.UnavailabilityPeriods))
+			if err := store.UpdateUnavailabilityPeriods(unavailability.Id, unavailability.Version, updatedPeriods); err != nil || !unblocked {
+				return err
+			}
+			service.compensatePeriods(transaction, unavailability)
+
+			toRemove.Id = primitive.NilObjectID
+			toRemove.Reason = domain.OwnerSet
+			return enqueueAvailabilityEvent(transaction, service.outbox, span, dto.AvailabilityEvent{
+				Type:            dto.PeriodUnblocked,
+				Actor:           dto.HostActor(unavailability.HostId),
+				AccommodationId: accommodationId,
+				HostId:          unavailability.HostId,
+				Period:          toRemove,
+				Stay:            stay,
+			})
+		})
+	})
+}
+
+func hasOwnerPeriodWithin(unavailability *domain.Unavailability, start time.Time, end time.Time) bool {
+	for _, period := range unavailability.UnavailabilityPeriods {
+		if period.Reason == domain.OwnerSet && periodsOverlap(period.Start, period.End, start, end) {
+			return true
+		}
+	}
+	return false
+}
+
 func (service *UnavailabilityService) GetAll(span trace.Span, loki promtail.Client) ([]*domain.Unavailability, error) {
 	util.HttpTraceInfo("Fetching unavailability...", span, loki, "GetAll", "")
 	return service.store.GetAll()
@@ -147,7 +620,13 @@ func (service *UnavailabilityService) GetByAccommodationId(id primitive.ObjectID
 	return service.store.GetByAccommodationId(id)
 }
 
+// DeleteByAccommodationId leaves a tombstone like deleteAccommodation, so that accommodation events still on their way
+// cannot recreate the accommodation.
 func (service *UnavailabilityService) DeleteByAccommodationId(id primitive.ObjectID, span trace.Span, loki promtail.Client) error {
+	util.HttpTraceInfo("Recording accommodation tombstone...", span, loki, "DeleteByAccommodationId", "")
+	if err := service.tombstones.Upsert(&domain.AccommodationTombstone{AccommodationId: id, DeletedAt: time.Now()}); err != nil {
+		return err
+	}
 	util.HttpTraceInfo("Deleting unavailability by accommodation id...", span, loki, "DeleteByAccommodationId", "")
 	return service.store.DeleteByAccommodationId(id)
 }
@@ -157,26 +636,42 @@ func (service *UnavailabilityService) GetByHostId(id string, span trace.Span, lo
 	return service.store.GetByHostId(id)
 }
 
+// Stays are compared in each accommodation's own timezone, so the periods are loaded in one query and checked here
+// rather than matched against a single range in Mongo.
 func (service *UnavailabilityService) FilterAvailable(ids []primitive.ObjectID, startDate time.Time, endDate time.Time, span trace.Span) ([]primitive.ObjectID, error) {
-	var response []primitive.ObjectID
-	for _, id := range ids {
-		unavailability, err := service.store.GetByAccommodationId(id)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	stay := stayFromInput(startDate, endDate)
+	if stay.Nights() <= 0 {
+		return nil, &domain.ValidationError{Message: "check-out must be after check-in"}
+	}
+
+	util.HttpTraceInfo("Fetching unavailable accommodation ids...", span, service.loki, "FilterAvailable", "")
+	windowStart, windowEnd := stay.PrefilterWindow()
+	candidateIds, err := service.store.GetUnavailableAccommodationIds(ids, windowStart, windowEnd)
+	if err != nil {
+		return nil, err
+	}
+	var unavailabilityList []*domain.Unavailability
+	if len(candidateIds) > 0 {
+		util.HttpTraceInfo("Fetching unavailability by accommodation ids...", span, service.loki, "FilterAvailable", "")
+		unavailabilityList, err = service.store.GetByAccommodationIds(candidateIds)
 		if err != nil {
 			return nil, err
 		}
-		if unavailability == nil {
-			response = append(response, id)
-			continue
-		}
-		available := true
-		for _, period := range unavailability.UnavailabilityPeriods {
-			if periodsOverlap(startDate, endDate, period.Start, period.End) {
-				available = false
-				break
-			}
+	}
+
+	unavailable := make(map[primitive.ObjectID]bool, len(unavailabilityList))
+	for _, unavailability := range unavailabilityList {
+		if len(findStayConflicts(unavailability, stay)) > 0 || checkStayRules(unavailability, stay) != nil {
+			unavailable[unavailability.AccommodationId] = true
 		}
+	}
 
-		if available {
+	var response []primitive.ObjectID
+	for _, id := range ids {
+		if !unavailable[id] {
 			response = append(response, id)
 		}
 	}
@@ -189,61 +684,76 @@ func (service *UnavailabilityService) DeleteHost(hostId string, span trace.Span,
 	if err != nil {
 		return false, err
 	}
+	now := time.Now()
 	for _, unavailability := range unavailabilityList {
 		for _, period := range unavailability.UnavailabilityPeriods {
-			log.Printf("start period %s\n", period.Start)
-			log.Printf("reason %d\n", period.Reason)
-			if isFuturePeriod(period) && period.Reason == domain.Reserved {
+			if isPeriodActiveOrUpcoming(unavailability, period, now) && period.Reason == domain.Reserved {
 				return false, nil
 			}
 		}
 	}
-	log.Printf("dosao 1 deleteHost")
 
-	service.produceDeleteAccommodationNotification(hostId, span)
-	log.Printf("dosao 2 deleteHost")
-	err = service.reservationRequestStore.DeleteByHost(hostId)
-	log.Printf("dosao 3 deleteHost")
+	err = service.transactions.WithTransaction(func(transaction domain.Transaction) error {
+		if err := service.produceDeleteAccommodationNotification(transaction, span, hostId); err != nil {
+			return err
+		}
+		util.HttpTraceInfo("Deleting reservation requests by host id...", span, loki, "DeleteHost", "")
+		return service.reservationRequestStore.WithContext(transaction.Context()).DeleteByHost(hostId)
+	})
 	if err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
-func (service *UnavailabilityService) IsAutomatic(accommodationId primitive.ObjectID, span trace.Span, loki promtail.Client) (bool, error) {
-	util.HttpTraceInfo("Fetching unavailability by accommodation...", span, loki, "IsAutomatic", "")
-	var accommodation, err = service.store.GetByAccommodationId(accommodationId)
-	log.Printf("accommodation: %v", accommodation)
-	if err != nil {
-		return false, err
+// Concurrent writers to the same accommodation lose the version check and retry on a fresh read. It wraps a whole
+// transaction rather than running inside one: a transaction keeps reading the snapshot it started with, so only a
+// new transaction sees the write that won.
+func retryOnConcurrentModification(operation func() error) error {
+	var err error
+	for attempt := 1; attempt <= maxUnavailabilityUpdateAttempts; attempt++ {
+		if err = operation(); !errors.Is(err, domain.ErrConcurrentModification) {
+			return err
+		}
+		time.Sleep(time.Duration(attempt) * unavailabilityUpdateBackoff)
 	}
-	return accommodation.ReviewReservationRequestAutomatically, nil
+	return err
 }
 
-func isFuturePeriod(period domain.UnavailabilityPeriod) bool {
-	now := time.Now()
-	return period.Start.After(now)
+func getResponseWindow(responseWindow time.Duration) time.Duration {
+	if responseWindow <= 0 {
+		return domain.DefaultReservationRequestResponseWindow
+	}
+	return responseWindow
+}
+
+// A stay that has started but not yet checked out still counts.
+func isPeriodActiveOrUpcoming(unavailability *domain.Unavailability, period domain.UnavailabilityPeriod, now time.Time) bool {
+	_, checkOut := getPeriodBounds(unavailability, period)
+	return now.Before(checkOut)
+}
+
+func findConflictingPeriods(unavailability *domain.Unavailability, start time.Time, end time.Time) []domain.UnavailabilityPeriod {
+	unavailability = withOccurrencesAround(unavailability, start, end)
+	var conflictingPeriods []domain.UnavailabilityPeriod
+	for _, period := range unavailability.UnavailabilityPeriods {
+		periodStart, periodEnd := getPeriodBounds(unavailability, period)
+		if periodsOverlap(start, end, periodStart, periodEnd) {
+			conflictingPeriods = append(conflictingPeriods, period)
+		}
+	}
+	return conflictingPeriods
 }
 
 func periodsOverlap(start1, end1, start2, end2 time.Time) bool {
 	return start1.Before(end2) && end1.After(start2)
 }
 
-func (service *UnavailabilityService) produceDeleteAccommodationNotification(hostId string, span trace.Span) {
+func (service *UnavailabilityService) produceDeleteAccommodationNotification(transaction domain.Transaction, span trace.Span, hostId string) error {
 	var topic = "accommodation.delete"
 
 	notificationDTO := dto.AccommodationDeleteNotification{
 		Id: hostId,
 	}
-	message, _ := json.Marshal(notificationDTO)
-	err := service.producer.Produce(&kafka.Message{
-		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
-		Value:          message,
-	}, nil)
-
-	if err != nil {
-		log.Fatalf("Failed to produce message: %s", err)
-	}
-
-	service.producer.Flush(4 * 1000)
+	return enqueueMessage(transaction, service.outbox, span, topic, hostId, notificationDTO)
 }
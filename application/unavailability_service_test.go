@@ -0,0 +1,86 @@
+package application
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+	"time"
+)
+
+func TestAddUnavailabilityPeriodDeclinesOnlyOverlappingPendingRequests(t *testing.T) {
+	accommodationId := primitive.NewObjectID()
+	unavailability := &domain.Unavailability{Id: primitive.NewObjectID(), AccommodationId: accommodationId, HostId: "host"}
+	checkIn := domain.DateOf(time.Now(), time.UTC).AddDays(30)
+	newPendingRequest := func(stay domain.Stay) *domain.ReservationRequest {
+		start, end := getStayBounds(unavailability, stay)
+		return &domain.ReservationRequest{Id: primitive.NewObjectID(), AccommodationId: accommodationId, HostId: "host", UserId: "guest", Start: start, End: end, Status: domain.Pending}
+	}
+	overlapping := newPendingRequest(domain.Stay{CheckIn: checkIn.AddDays(1), CheckOut: checkIn.AddDays(4)})
+	later := newPendingRequest(domain.Stay{CheckIn: checkIn.AddDays(10), CheckOut: checkIn.AddDays(12)})
+
+	reservationRequests := newFakeReservationRequestStore(overlapping, later)
+	outbox := &fakeOutboxStore{}
+	service := NewUnavailabilityService(newFakeUnavailabilityStore(unavailability), fakeTransactionManager{}, outbox, reservationRequests, nil, nil, noopLoki{})
+
+	period := &domain.UnavailabilityPeriod{
+		Start:  checkIn.At(domain.TimeOfDay{}, time.UTC),
+		End:    checkIn.AddDays(3).At(domain.TimeOfDay{}, time.UTC),
+		Reason: domain.OwnerSet,
+	}
+	if err := service.AddUnavailabilityPeriod(accommodationId, period, noSpan, noopLoki{}); err != nil {
+		t.Fatalf("adding the period failed: %v", err)
+	}
+
+	if stored, _ := reservationRequests.Get(overlapping.Id); stored.Status != domain.DeclinedByHost {
+		t.Errorf("overlapping request has status %v, want declined by host", stored.Status)
+	}
+	if stored, _ := reservationRequests.Get(later.Id); stored.Status != domain.Pending {
+		t.Errorf("request for later dates has status %v, want pending", stored.Status)
+	}
+
+	var declinedKeys []string
+	for _, message := range outbox.messages {
+		if message.Type == dto.ReservationDeclined {
+			declinedKeys = append(declinedKeys, message.Key)
+		}
+	}
+	if len(declinedKeys) != 1 || declinedKeys[0] != overlapping.Id.Hex() {
+		t.Errorf("declined events for %v, want one for %s", declinedKeys, overlapping.Id.Hex())
+	}
+}
+
+func TestRemoveUnavailabilityPeriodKeepsAdjacentBlock(t *testing.T) {
+	accommodationId := primitive.NewObjectID()
+	// With equal check-in and check-out times a stay ends at the instant the next one starts.
+	noon := domain.TimeOfDay{Hour: 12}
+	unavailability := &domain.Unavailability{Id: primitive.NewObjectID(), AccommodationId: accommodationId, HostId: "host", TimeZone: "UTC", CheckInTime: &noon, CheckOutTime: &noon}
+	checkIn := domain.DateOf(time.Now(), time.UTC).AddDays(30)
+	removed := domain.Stay{CheckIn: checkIn, CheckOut: checkIn.AddDays(2)}
+	adjacent := domain.Stay{CheckIn: removed.CheckOut, CheckOut: removed.CheckOut.AddDays(3)}
+	for _, stay := range []domain.Stay{removed, adjacent} {
+		start, end := getStayBounds(unavailability, stay)
+		unavailability.UnavailabilityPeriods = append(unavailability.UnavailabilityPeriods, domain.UnavailabilityPeriod{Id: primitive.NewObjectID(), Start: start, End: end, Reason: domain.OwnerSet})
+	}
+	adjacentPeriod := unavailability.UnavailabilityPeriods[1]
+
+	store := newFakeUnavailabilityStore(unavailability)
+	service := NewUnavailabilityService(store, fakeTransactionManager{}, &fakeOutboxStore{}, newFakeReservationRequestStore(), nil, nil, noopLoki{})
+
+	period := &domain.UnavailabilityPeriod{
+		Start:  removed.CheckIn.At(domain.TimeOfDay{}, time.UTC),
+		End:    removed.CheckOut.At(domain.TimeOfDay{}, time.UTC),
+		Reason: domain.OwnerSet,
+	}
+	if err := service.RemoveUnavailabilityPeriod(accommodationId, period, noSpan, noopLoki{}); err != nil {
+		t.Fatalf("removing the period failed: %v", err)
+	}
+
+	stored, _ := store.GetByAccommodationId(accommodationId)
+	if len(stored.UnavailabilityPeriods) != 1 {
+		t.Fatalf("%d periods left, want only the adjacent block", len(stored.UnavailabilityPeriods))
+	}
+	if left := stored.UnavailabilityPeriods[0]; left.Id != adjacentPeriod.Id || !left.Start.Equal(adjacentPeriod.Start) || !left.End.Equal(adjacentPeriod.End) {
+		t.Errorf("period %s to %s is left, want the adjacent block %s to %s", left.Start, left.End, adjacentPeriod.Start, adjacentPeriod.End)
+	}
+}
@@ -0,0 +1,55 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
+	"github.com/afiskon/promtail-client/promtail"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const userEventConsumer = "user-events"
+
+const (
+	userDeletedTopic    = "user.deleted"
+	userAnonymizedTopic = "booking.user-anonymized"
+)
+
+var UserEventTopics = []string{userDeletedTopic}
+
+// UserEventConsumer anonymizes the reservation requests of deleted guests as a step of the account deletion saga.
+type UserEventConsumer struct {
+	reservationRequestService *ReservationRequestService
+	processedEvents           domain.ProcessedEventStore
+	transactions              domain.TransactionManager
+	tracer                    trace.Tracer
+	loki                      promtail.Client
+}
+
+func NewUserEventConsumer(reservationRequestService *ReservationRequestService, processedEvents domain.ProcessedEventStore, transactions domain.TransactionManager, tracer trace.Tracer, loki promtail.Client) *UserEventConsumer {
+	return &UserEventConsumer{
+		reservationRequestService: reservationRequestService,
+		processedEvents:           processedEvents,
+		transactions:              transactions,
+		tracer:                    tracer,
+		loki:                      loki,
+	}
+}
+
+func (consumer *UserEventConsumer) Handle(event domain.Event) error {
+	span := startConsumerSpan(consumer.tracer, event)
+	defer func() { span.End() }()
+
+	var userEvent dto.UserDeletedEventDto
+	if err := json.Unmarshal(event.Payload, &userEvent); err != nil {
+		return &domain.ValidationError{Message: fmt.Sprintf("invalid %s event %s: %v", event.Topic, event.Id, err)}
+	}
+	if userEvent.Id == "" {
+		return &domain.ValidationError{Message: fmt.Sprintf("%s event %s has no user id", event.Topic, event.Id)}
+	}
+
+	return consumeOnce(consumer.transactions, consumer.processedEvents, userEventConsumer, event, span, consumer.loki, func(transaction domain.Transaction) error {
+		return consumer.reservationRequestService.anonymizeClient(transaction, userEvent.Id, event.Id, span, consumer.loki)
+	})
+}
@@ -0,0 +1,62 @@
+package application
+
+import (
+	"encoding/json"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/trace"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUserDeletionDeclinesPendingRequestsBeforeAnonymizing(t *testing.T) {
+	checkIn := time.Now().AddDate(0, 1, 0)
+	newRequest := func(status domain.ReservationRequestStatus) *domain.ReservationRequest {
+		return &domain.ReservationRequest{
+			Id:              primitive.NewObjectID(),
+			AccommodationId: primitive.NewObjectID(),
+			HostId:          "host",
+			UserId:          "guest",
+			Start:           checkIn,
+			End:             checkIn.AddDate(0, 0, 2),
+			Status:          status,
+		}
+	}
+	pending := newRequest(domain.Pending)
+	completed := newRequest(domain.Completed)
+	requests := newFakeReservationRequestStore(pending, completed)
+	outbox := &fakeOutboxStore{}
+	unavailabilityService := NewUnavailabilityService(newFakeUnavailabilityStore(), fakeTransactionManager{}, outbox, requests, nil, nil, noopLoki{})
+	service := NewReservationRequestService(requests, unavailabilityService, fakeTransactionManager{}, outbox, noopLoki{})
+	consumer := NewUserEventConsumer(service, &fakeProcessedEventStore{}, fakeTransactionManager{}, trace.NewNoopTracerProvider().Tracer(""), noopLoki{})
+
+	payload, _ := json.Marshal(dto.UserDeletedEventDto{Id: "guest"})
+	if err := consumer.Handle(domain.Event{Id: "deletion", Topic: userDeletedTopic, Payload: payload}); err != nil {
+		t.Fatalf("handling the deletion failed: %v", err)
+	}
+
+	for _, request := range []*domain.ReservationRequest{pending, completed} {
+		stored, _ := requests.Get(request.Id)
+		if !strings.HasPrefix(stored.UserId, domain.TombstoneUserIdPrefix) {
+			t.Errorf("request %s still belongs to %q", request.Id.Hex(), stored.UserId)
+		}
+	}
+	if stored, _ := requests.Get(pending.Id); stored.Status != domain.DeclinedByUser {
+		t.Errorf("pending request has status %v after the guest was deleted, want declined", stored.Status)
+	}
+	if stored, _ := requests.Get(completed.Id); stored.Status != domain.Completed {
+		t.Errorf("completed request has status %v after the guest was deleted, want completed", stored.Status)
+	}
+
+	var declinedKeys []string
+	for _, message := range outbox.messages {
+		if message.Type == dto.ReservationDeclined {
+			declinedKeys = append(declinedKeys, message.Key)
+		}
+	}
+	if len(declinedKeys) != 1 || declinedKeys[0] != pending.Id.Hex() {
+		t.Errorf("declined events for %v, want one for %s", declinedKeys, pending.Id.Hex())
+	}
+}
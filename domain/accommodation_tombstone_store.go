@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AccommodationTombstoneStore interface {
+	WithContext(ctx context.Context) AccommodationTombstoneStore
+	Get(accommodationId primitive.ObjectID) (*AccommodationTombstone, error)
+	Upsert(tombstone *AccommodationTombstone) error
+	Delete(accommodationId primitive.ObjectID) error
+}
@@ -0,0 +1,13 @@
+package domain
+
+// CalendarFeed is a fetched feed. NotModified reports that the feed is unchanged since the fetch that returned the
+// ETag sent along, in which case it carries no events.
+type CalendarFeed struct {
+	Events      []CalendarEvent
+	ETag        string
+	NotModified bool
+}
+
+type CalendarFeedFetcher interface {
+	Fetch(url string, etag string) (*CalendarFeed, error)
+}
@@ -1,5 +1,19 @@
 package domain
 
+import "time"
+
 const (
 	ServiceName string = "booking-service"
+	// TombstoneUserIdPrefix marks user ids that replaced those of deleted accounts.
+	TombstoneUserIdPrefix = "deleted-"
+)
+
+const (
+	DefaultReservationRequestResponseWindow = 24 * time.Hour
+	MaxTurnoverBuffer                       = 7 * 24 * time.Hour
+)
+
+var (
+	DefaultCheckInTime  = TimeOfDay{Hour: 15}
+	DefaultCheckOutTime = TimeOfDay{Hour: 11}
 )
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	ErrAccommodationNotFound    = errors.New("accommodation not found")
+	ErrConcurrentModification   = errors.New("unavailability was modified concurrently")
+	ErrRecurringBlockNotFound   = errors.New("recurring block not found")
+	ErrInvalidICalToken         = errors.New("invalid calendar token")
+	ErrExternalCalendarNotFound = errors.New("external calendar not found")
+	ErrCurrencyMismatch         = errors.New("currency mismatch")
+	ErrPricingNotConfigured     = errors.New("pricing not configured")
+)
+
+type ValidationError struct {
+	Message string
+}
+
+func (err *ValidationError) Error() string {
+	return err.Message
+}
+
+type ReservationConflictError struct {
+	AccommodationId    primitive.ObjectID
+	ConflictingPeriods []UnavailabilityPeriod
+}
+
+func (err *ReservationConflictError) Error() string {
+	return "requested period overlaps existing unavailability"
+}
@@ -0,0 +1,7 @@
+package domain
+
+// EventHandler processes a consumed event. A ValidationError marks an event that can never be processed; any other
+// error leaves it to be retried.
+type EventHandler interface {
+	Handle(event Event) error
+}
@@ -0,0 +1,5 @@
+package domain
+
+type EventPublisher interface {
+	Publish(event Event) error
+}
@@ -0,0 +1,7 @@
+package domain
+
+import "time"
+
+type LeaseStore interface {
+	Acquire(name string, owner string, ttl time.Duration) (bool, error)
+}
@@ -12,13 +12,34 @@ type Unavailability struct {
 	HostId                                string                 `bson:"host_id"`
 	UnavailabilityPeriods                 []UnavailabilityPeriod `bson:"unavailability_periods"`
 	ReviewReservationRequestAutomatically bool                   `bson:"review_reservation_request_automatically"`
+	ReservationRequestResponseWindow      time.Duration          `bson:"reservation_request_response_window"`
+	CancellationPolicy                    CancellationPolicy     `bson:"cancellation_policy"`
+	Pricing                               Pricing                `bson:"pricing"`
+	StayRules                             StayRules              `bson:"stay_rules"`
+	TurnoverBuffer                        TurnoverBuffer         `bson:"turnover_buffer"`
+	TimeZone                              string                 `bson:"time_zone,omitempty"`
+	CheckInTime                           *TimeOfDay             `bson:"check_in_time,omitempty"`
+	CheckOutTime                          *TimeOfDay             `bson:"check_out_time,omitempty"`
+	RecurringBlocks                       []RecurringBlock       `bson:"recurring_blocks,omitempty"`
+	ICalExportToken                       string                 `bson:"ical_export_token,omitempty"`
+	ExternalCalendars                     []ExternalCalendar     `bson:"external_calendars,omitempty"`
+	SourceUpdatedAt                       time.Time              `bson:"source_updated_at,omitempty"`
+	Version                               int64                  `bson:"version"`
 }
 
 type UnavailabilityPeriod struct {
-	Id     primitive.ObjectID   `bson:"_id"`
-	Start  time.Time            `bson:"start"`
-	End    time.Time            `bson:"end"`
-	Reason UnavailabilityReason `bson:"reason"`
+	Id            primitive.ObjectID   `bson:"_id"`
+	Start         time.Time            `bson:"start"`
+	End           time.Time            `bson:"end"`
+	Reason        UnavailabilityReason `bson:"reason"`
+	ReservationId primitive.ObjectID   `bson:"reservation_id,omitempty"`
+	BlockedStart  time.Time            `bson:"blocked_start,omitempty"`
+	BlockedEnd    time.Time            `bson:"blocked_end,omitempty"`
+	// ExternalCalendarId and ExternalUID identify the imported event an External period was created from.
+	ExternalCalendarId primitive.ObjectID `bson:"external_calendar_id,omitempty"`
+	ExternalUID        string             `bson:"external_uid,omitempty"`
+	// RecurrenceId is set on occurrences expanded from a RecurringBlock, which are never stored as periods.
+	RecurrenceId *Date `bson:"-"`
 }
 
 type UnavailabilityReason int
@@ -26,8 +47,162 @@ type UnavailabilityReason int
 const (
 	Reserved UnavailabilityReason = iota
 	OwnerSet
+	External
 )
 
+// ExternalCalendar is an iCalendar feed of another channel whose events are imported as External periods.
+type ExternalCalendar struct {
+	Id            primitive.ObjectID         `bson:"_id"`
+	Name          string                     `bson:"name"`
+	Url           string                     `bson:"url"`
+	LastSyncedAt  time.Time                  `bson:"last_synced_at,omitempty"`
+	LastSyncError string                     `bson:"last_sync_error,omitempty"`
+	ETag          string                     `bson:"etag,omitempty"`
+	Conflicts     []ExternalCalendarConflict `bson:"conflicts,omitempty"`
+}
+
+// ExternalCalendarConflict is an imported event that overlaps a reservation made here.
+type ExternalCalendarConflict struct {
+	UID              string             `bson:"uid"`
+	Stay             Stay               `bson:"stay"`
+	ReservedPeriodId primitive.ObjectID `bson:"reserved_period_id"`
+	ReservationId    primitive.ObjectID `bson:"reservation_id,omitempty"`
+}
+
+type TurnoverBuffer struct {
+	Before time.Duration `bson:"before"`
+	After  time.Duration `bson:"after"`
+}
+
+type CancellationPolicy struct {
+	Type  CancellationPolicyType `bson:"type"`
+	Tiers []RefundTier           `bson:"tiers"`
+}
+
+type CancellationPolicyType int
+
+const (
+	Flexible CancellationPolicyType = iota
+	Moderate
+	Strict
+	NonRefundable
+	CustomCancellationPolicy
+)
+
+type RefundTier struct {
+	DaysBeforeStart  int `bson:"days_before_start"`
+	RefundPercentage int `bson:"refund_percentage"`
+}
+
+type Pricing struct {
+	Type                      PricingType     `bson:"type"`
+	NightlyRate               Money           `bson:"nightly_rate"`
+	Overrides                 []PriceOverride `bson:"overrides"`
+	WeeklyDiscountPercentage  int             `bson:"weekly_discount_percentage"`
+	MonthlyDiscountPercentage int             `bson:"monthly_discount_percentage"`
+	CleaningFee               Money           `bson:"cleaning_fee"`
+	ServiceFeePercentage      int             `bson:"service_fee_percentage"`
+}
+
+type PricingType int
+
+const (
+	PerUnit PricingType = iota
+	PerGuest
+)
+
+type PriceOverride struct {
+	Start       time.Time `bson:"start"`
+	End         time.Time `bson:"end"`
+	NightlyRate Money     `bson:"nightly_rate"`
+}
+
+type PriceQuote struct {
+	AccommodationId primitive.ObjectID
+	Start           time.Time
+	End             time.Time
+	NumberOfGuests  int
+	Nights          []NightlyPrice
+	Subtotal        Money
+	Discount        Money
+	CleaningFee     Money
+	ServiceFee      Money
+	Total           Money
+}
+
+type NightlyPrice struct {
+	Date  Date
+	Price Money
+}
+
+type StayRules struct {
+	MinNights   int                `bson:"min_nights"`
+	MaxNights   int                `bson:"max_nights"`
+	CheckInDays []time.Weekday     `bson:"check_in_days"`
+	Overrides   []StayRuleOverride `bson:"overrides"`
+}
+
+type StayRuleOverride struct {
+	Start       time.Time      `bson:"start"`
+	End         time.Time      `bson:"end"`
+	MinNights   int            `bson:"min_nights"`
+	MaxNights   int            `bson:"max_nights"`
+	CheckInDays []time.Weekday `bson:"check_in_days"`
+}
+
+type CalendarDay struct {
+	Date          Date
+	State         CalendarDayState
+	PeriodId      primitive.ObjectID
+	ReservationId primitive.ObjectID
+	RecurrenceId  *Date
+	CheckIn       bool
+	CheckOut      bool
+}
+
+type CalendarDayState int
+
+const (
+	AvailableDay CalendarDayState = iota
+	ReservedDay
+	OwnerBlockedDay
+	PendingRequestedDay
+	ExternalBlockedDay
+)
+
+// CalendarEvent is an all-day entry of an exported calendar; recurring ones carry their rule and exceptions, and
+// moved occurrences share the UID of their series with a RecurrenceId.
+type CalendarEvent struct {
+	UID          string
+	Summary      string
+	Stay         Stay
+	Rule         *RecurrenceRule
+	Exceptions   []Date
+	RecurrenceId *Date
+}
+
+type FlexibleAvailability struct {
+	AccommodationId primitive.ObjectID
+	Stays           []FlexibleStay
+}
+
+type FlexibleStay struct {
+	CheckIn   Date
+	MaxNights int
+}
+
+type AvailabilityAlternatives struct {
+	AccommodationId    primitive.ObjectID
+	ConflictingPeriods []UnavailabilityPeriod
+	Earlier            *DateRange
+	Later              *DateRange
+}
+
+type DateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
 type ReservationRequest struct {
 	Id                primitive.ObjectID       `bson:"_id"`
 	AccommodationId   primitive.ObjectID       `bson:"accommodation_id"`
@@ -37,8 +212,15 @@ type ReservationRequest struct {
 	Start             time.Time                `bson:"start"`
 	End               time.Time                `bson:"end"`
 	NumberOfGuests    int                      `bson:"number_of_guests"`
-	PriceTotal        float32                  `bson:"price_total"`
+	PriceTotal        Money                    `bson:"price_total"`
 	Status            ReservationRequestStatus `bson:"status"`
+	ExpiresAt         time.Time                `bson:"expires_at"`
+	RemindAt          time.Time                `bson:"remind_at"`
+	HostReminded      bool                     `bson:"host_reminded"`
+	RefundAmount      Money                    `bson:"refund_amount"`
+	CanceledAt        time.Time                `bson:"canceled_at,omitempty"`
+	// AnonymizedAt is set once the guest's account was deleted and UserId replaced by a tombstone id.
+	AnonymizedAt time.Time `bson:"anonymized_at,omitempty"`
 }
 
 type ReservationRequestStatus int
@@ -49,4 +231,58 @@ const (
 	DeclinedByUser
 	DeclinedByHost
 	Completed
+	Expired
 )
+
+type Lease struct {
+	Name      string    `bson:"_id"`
+	Owner     string    `bson:"owner"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+// Event is a message handed to an EventPublisher or an EventHandler; Id identifies it across redeliveries. Payloads
+// are JSON. Partition and Offset are only set on consumed events.
+type Event struct {
+	Id           string
+	Type         string
+	Time         time.Time
+	Topic        string
+	Key          string
+	Payload      []byte
+	TraceContext map[string]string
+	Partition    int32
+	Offset       int64
+}
+
+// ProcessedEvent records that a consumer has applied an event, so a redelivery of it is skipped.
+type ProcessedEvent struct {
+	Id          string    `bson:"_id"`
+	Consumer    string    `bson:"consumer"`
+	EventId     string    `bson:"event_id"`
+	Topic       string    `bson:"topic"`
+	Partition   int32     `bson:"partition"`
+	Offset      int64     `bson:"offset"`
+	ProcessedAt time.Time `bson:"processed_at"`
+}
+
+// AccommodationTombstone records that an accommodation was deleted, so that its events arriving late cannot recreate it.
+type AccommodationTombstone struct {
+	AccommodationId primitive.ObjectID `bson:"_id"`
+	DeletedAt       time.Time          `bson:"deleted_at"`
+}
+
+// OutboxMessage is an event recorded together with the state change it announces and published by the outbox relay.
+type OutboxMessage struct {
+	Id            primitive.ObjectID `bson:"_id"`
+	Type          string             `bson:"type"`
+	Topic         string             `bson:"topic"`
+	Key           string             `bson:"key"`
+	Payload       []byte             `bson:"payload"`
+	CreatedAt     time.Time          `bson:"created_at"`
+	Attempts      int                `bson:"attempts"`
+	NextAttemptAt time.Time          `bson:"next_attempt_at"`
+	LastError     string             `bson:"last_error,omitempty"`
+	DeliveredAt   *time.Time         `bson:"delivered_at,omitempty"`
+	// TraceContext holds the W3C trace context headers of the span that recorded the message.
+	TraceContext map[string]string `bson:"trace_context,omitempty"`
+}
@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Money struct {
+	Amount   int64  `bson:"amount"`
+	Currency string `bson:"currency"`
+}
+
+// ISO-4217 currencies whose minor unit is not a hundredth of the major unit.
+var currencyExponents = map[string]int{
+	"BHD": 3,
+	"CLP": 0,
+	"IQD": 3,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"LYD": 3,
+	"OMR": 3,
+	"TND": 3,
+	"UGX": 0,
+	"VND": 0,
+}
+
+func CurrencyExponent(currency string) int {
+	if exponent, ok := currencyExponents[currency]; ok {
+		return exponent
+	}
+	return 2
+}
+
+func IsValidCurrency(currency string) bool {
+	if len(currency) != 3 {
+		return false
+	}
+	for _, letter := range currency {
+		if letter < 'A' || letter > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+func ParseMoney(amount string, currency string) (Money, error) {
+	if !IsValidCurrency(currency) {
+		return Money{}, fmt.Errorf("invalid currency %q", currency)
+	}
+	exponent := CurrencyExponent(currency)
+
+	whole, fraction, hasFraction := strings.Cut(amount, ".")
+	if hasFraction && len(fraction) == 0 {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	if len(fraction) > exponent {
+		return Money{}, fmt.Errorf("amount %q has more than %d decimal places", amount, exponent)
+	}
+	negative := strings.HasPrefix(whole, "-")
+	whole = strings.TrimPrefix(whole, "-")
+	if whole == "" || strings.ContainsAny(whole, "+-") || strings.ContainsAny(fraction, "+-") {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+
+	minorUnits, err := strconv.ParseInt(whole+fraction+strings.Repeat("0", exponent-len(fraction)), 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	if negative {
+		minorUnits = -minorUnits
+	}
+	return Money{Amount: minorUnits, Currency: currency}, nil
+}
+
+func (money Money) FormatAmount() string {
+	exponent := CurrencyExponent(money.Currency)
+	amount := money.Amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	digits := fmt.Sprintf("%0*d", exponent+1, amount)
+	if exponent == 0 {
+		return sign + digits
+	}
+	return sign + digits[:len(digits)-exponent] + "." + digits[len(digits)-exponent:]
+}
+
+func (money Money) String() string {
+	return money.FormatAmount() + " " + money.Currency
+}
+
+// Add and Sub fail with ErrCurrencyMismatch when the currencies differ, rather than mixing them. A zero amount without a
+// currency, such as an unset fee, takes the other operand's currency.
+func (money Money) Add(other Money) (Money, error) {
+	currency, err := getCommonCurrency(money, other)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: money.Amount + other.Amount, Currency: currency}, nil
+}
+
+func (money Money) Sub(other Money) (Money, error) {
+	currency, err := getCommonCurrency(money, other)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: money.Amount - other.Amount, Currency: currency}, nil
+}
+
+func getCommonCurrency(money Money, other Money) (string, error) {
+	if money.Currency == other.Currency || (other.Currency == "" && other.Amount == 0) {
+		return money.Currency, nil
+	}
+	if money.Currency == "" && money.Amount == 0 {
+		return other.Currency, nil
+	}
+	return "", fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, money, other)
+}
+
+func (money Money) Multiply(factor int64) Money {
+	return Money{Amount: money.Amount * factor, Currency: money.Currency}
+}
+
+// Percentage rounds half away from zero to the nearest minor unit.
+func (money Money) Percentage(percentage int) Money {
+	amount := money.Amount * int64(percentage)
+	if amount >= 0 {
+		amount = (amount + 50) / 100
+	} else {
+		amount = (amount - 50) / 100
+	}
+	return Money{Amount: amount, Currency: money.Currency}
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMoneyAddRejectsCurrencyMismatch(t *testing.T) {
+	if _, err := (Money{Amount: 100, Currency: "EUR"}).Add(Money{Amount: 100, Currency: "USD"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("adding EUR to USD returned %v, want a currency mismatch", err)
+	}
+	if _, err := (Money{Currency: "EUR"}).Sub(Money{Currency: "USD"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("subtracting zero USD from zero EUR returned %v, want a currency mismatch", err)
+	}
+}
+
+func TestMoneyAddTakesCurrencyOfUnsetZero(t *testing.T) {
+	fee := Money{Amount: 500, Currency: "EUR"}
+	if sum, err := (Money{}).Add(fee); err != nil || sum != fee {
+		t.Errorf("zero plus %s is %s, %v", fee, sum, err)
+	}
+	if difference, err := fee.Sub(Money{}); err != nil || difference != fee {
+		t.Errorf("%s minus zero is %s, %v", fee, difference, err)
+	}
+}
@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+type OutboxStore interface {
+	WithContext(ctx context.Context) OutboxStore
+	Insert(message *OutboxMessage) error
+	Delete(id primitive.ObjectID) error
+	GetDue(now time.Time, limit int) ([]*OutboxMessage, error)
+	MarkDelivered(id primitive.ObjectID, deliveredAt time.Time) error
+	MarkFailed(id primitive.ObjectID, nextAttemptAt time.Time, lastError string) error
+	GetBacklog() (int64, time.Time, error)
+}
@@ -0,0 +1,10 @@
+package domain
+
+import "context"
+
+type ProcessedEventStore interface {
+	WithContext(ctx context.Context) ProcessedEventStore
+	Exists(consumer string, eventId string) (bool, error)
+	Insert(event *ProcessedEvent) error
+	Delete(consumer string, eventId string) error
+}
@@ -0,0 +1,222 @@
+package domain
+
+import (
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type RecurrenceFrequency int
+
+const (
+	Daily RecurrenceFrequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+var recurrenceFrequencies = map[string]RecurrenceFrequency{
+	"DAILY":   Daily,
+	"WEEKLY":  Weekly,
+	"MONTHLY": Monthly,
+	"YEARLY":  Yearly,
+}
+
+var recurrenceWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// RecurrenceRule holds the subset of an RFC 5545 RRULE used for owner blocks. Weeks start on Monday.
+type RecurrenceRule struct {
+	Frequency  RecurrenceFrequency `bson:"frequency"`
+	Interval   int                 `bson:"interval"`
+	Count      int                 `bson:"count,omitempty"`
+	Until      *Date               `bson:"until,omitempty"`
+	ByDay      []RecurrenceDay     `bson:"by_day,omitempty"`
+	ByMonthDay []int               `bson:"by_month_day,omitempty"`
+	ByMonth    []time.Month        `bson:"by_month,omitempty"`
+}
+
+// RecurrenceDay is a BYDAY entry; a non-zero Ordinal picks the n-th (or, when negative, n-th last) such weekday of the month.
+type RecurrenceDay struct {
+	Ordinal int          `bson:"ordinal,omitempty"`
+	Weekday time.Weekday `bson:"weekday"`
+}
+
+// RecurringBlock repeats an owner block of Nights nights starting on every date the rule yields from Start.
+// Exceptions remove single occurrences and ModifiedOccurrences move them, both keyed by the date they replace.
+type RecurringBlock struct {
+	Id                  primitive.ObjectID   `bson:"_id"`
+	Start               Date                 `bson:"start"`
+	Nights              int                  `bson:"nights"`
+	Rule                RecurrenceRule       `bson:"rule"`
+	Exceptions          []Date               `bson:"exceptions,omitempty"`
+	ModifiedOccurrences []ModifiedOccurrence `bson:"modified_occurrences,omitempty"`
+}
+
+type ModifiedOccurrence struct {
+	RecurrenceId Date `bson:"recurrence_id"`
+	Stay         Stay `bson:"stay"`
+}
+
+// ParseRecurrenceRule reads an RRULE value such as "FREQ=WEEKLY;BYDAY=MO;UNTIL=20271231".
+func ParseRecurrenceRule(value string) (RecurrenceRule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	rule := RecurrenceRule{Interval: 1}
+	hasFrequency := false
+	for _, part := range strings.Split(value, ";") {
+		name, argument, found := strings.Cut(part, "=")
+		if !found {
+			return RecurrenceRule{}, fmt.Errorf("invalid rule part %q", part)
+		}
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			frequency, ok := recurrenceFrequencies[strings.ToUpper(argument)]
+			if !ok {
+				return RecurrenceRule{}, fmt.Errorf("unsupported frequency %q", argument)
+			}
+			rule.Frequency = frequency
+			hasFrequency = true
+		case "INTERVAL":
+			interval, err := strconv.Atoi(argument)
+			if err != nil || interval <= 0 {
+				return RecurrenceRule{}, fmt.Errorf("invalid interval %q", argument)
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(argument)
+			if err != nil || count <= 0 {
+				return RecurrenceRule{}, fmt.Errorf("invalid count %q", argument)
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := parseRecurrenceDate(argument)
+			if err != nil {
+				return RecurrenceRule{}, fmt.Errorf("invalid until %q", argument)
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, day := range strings.Split(argument, ",") {
+				recurrenceDay, err := parseRecurrenceDay(day)
+				if err != nil {
+					return RecurrenceRule{}, err
+				}
+				rule.ByDay = append(rule.ByDay, recurrenceDay)
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(argument, ",") {
+				monthDay, err := strconv.Atoi(day)
+				if err != nil || monthDay == 0 || monthDay < -31 || monthDay > 31 {
+					return RecurrenceRule{}, fmt.Errorf("invalid month day %q", day)
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, monthDay)
+			}
+		case "BYMONTH":
+			for _, month := range strings.Split(argument, ",") {
+				number, err := strconv.Atoi(month)
+				if err != nil || number < 1 || number > 12 {
+					return RecurrenceRule{}, fmt.Errorf("invalid month %q", month)
+				}
+				rule.ByMonth = append(rule.ByMonth, time.Month(number))
+			}
+		case "WKST":
+			if strings.ToUpper(argument) != "MO" {
+				return RecurrenceRule{}, fmt.Errorf("unsupported week start %q", argument)
+			}
+		default:
+			return RecurrenceRule{}, fmt.Errorf("unsupported rule part %q", name)
+		}
+	}
+	if !hasFrequency {
+		return RecurrenceRule{}, fmt.Errorf("rule needs a FREQ")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return RecurrenceRule{}, fmt.Errorf("rule cannot have both COUNT and UNTIL")
+	}
+	return rule, nil
+}
+
+// UNTIL is accepted as a date or a date-time; only its date is used.
+func parseRecurrenceDate(value string) (Date, error) {
+	if len(value) < 8 {
+		return Date{}, fmt.Errorf("invalid date %q", value)
+	}
+	t, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t, time.UTC), nil
+}
+
+func parseRecurrenceDay(value string) (RecurrenceDay, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if len(value) < 2 {
+		return RecurrenceDay{}, fmt.Errorf("invalid day %q", value)
+	}
+	weekday, ok := recurrenceWeekdays[value[len(value)-2:]]
+	if !ok {
+		return RecurrenceDay{}, fmt.Errorf("invalid day %q", value)
+	}
+	day := RecurrenceDay{Weekday: weekday}
+	if ordinal := value[:len(value)-2]; ordinal != "" {
+		number, err := strconv.Atoi(ordinal)
+		if err != nil || number == 0 || number < -5 || number > 5 {
+			return RecurrenceDay{}, fmt.Errorf("invalid day %q", value)
+		}
+		day.Ordinal = number
+	}
+	return day, nil
+}
+
+func (rule RecurrenceRule) String() string {
+	var frequency string
+	for name, value := range recurrenceFrequencies {
+		if value == rule.Frequency {
+			frequency = name
+		}
+	}
+	parts := []string{"FREQ=" + frequency}
+	if rule.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(rule.Interval))
+	}
+	if rule.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(rule.Count))
+	}
+	if rule.Until != nil {
+		parts = append(parts, fmt.Sprintf("UNTIL=%04d%02d%02d", rule.Until.Year, rule.Until.Month, rule.Until.Day))
+	}
+	if len(rule.ByDay) > 0 {
+		var days []string
+		for _, day := range rule.ByDay {
+			name := strings.ToUpper(day.Weekday.String()[:2])
+			if day.Ordinal != 0 {
+				name = strconv.Itoa(day.Ordinal) + name
+			}
+			days = append(days, name)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(rule.ByMonthDay) > 0 {
+		var days []string
+		for _, day := range rule.ByMonthDay {
+			days = append(days, strconv.Itoa(day))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if len(rule.ByMonth) > 0 {
+		var months []string
+		for _, month := range rule.ByMonth {
+			months = append(months, strconv.Itoa(int(month)))
+		}
+		parts = append(parts, "BYMONTH="+strings.Join(months, ","))
+	}
+	return strings.Join(parts, ";")
+}
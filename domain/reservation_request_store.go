@@ -1,8 +1,13 @@
 package domain
 
-import "go.mongodb.org/mongo-driver/bson/primitive"
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
 
 type ReservationRequestStore interface {
+	WithContext(ctx context.Context) ReservationRequestStore
 	Get(id primitive.ObjectID) (*ReservationRequest, error)
 	Insert(reservationRequest *ReservationRequest) (*primitive.ObjectID, error)
 	Update(id primitive.ObjectID, reservationRequest *ReservationRequest) error
@@ -11,14 +16,24 @@ type ReservationRequestStore interface {
 	GetByAccommodationId(accommodationId primitive.ObjectID) ([]*ReservationRequest, error)
 	GetByAccommodationIdAndType(id primitive.ObjectID, requestType ReservationRequestStatus) ([]*ReservationRequest, error)
 	Delete(id primitive.ObjectID) error
-	CancelOverlappingPendingRequests(request *ReservationRequest) error
+	CancelOverlappingPendingRequests(request *ReservationRequest, start time.Time, end time.Time) ([]primitive.ObjectID, error)
+	RestorePendingRequests(ids []primitive.ObjectID) error
 	GetByClientId(clientId string) ([]*ReservationRequest, error)
 	GetByClientIdAndStatus(clientId string, status ReservationRequestStatus) ([]*ReservationRequest, error)
 	GetByHostAndTimeAndSearch(userId string, past bool, search string) ([]*ReservationRequest, error)
 	GetByClientIdAndTimeAndSearch(guestId string, past bool, search string) ([]*ReservationRequest, error)
 	DeleteByHost(hostId string) error
 	GetByClientIdAndHostId(reviewerId string, hostId string) ([]*ReservationRequest, error)
-	GetPastAcceptedReservationRequestByClientIdAndHostId(reviewerId string, hostId string) ([]*ReservationRequest, error)
-	GetByClientIdAndAccommodationId(reviewerId string, accommodationId primitive.ObjectID) ([]*ReservationRequest, error)
+	GetCompletedByClientIdAndHostId(reviewerId string, hostId string) ([]*ReservationRequest, error)
+	GetCompletedByClientIdAndAccommodationId(reviewerId string, accommodationId primitive.ObjectID) ([]*ReservationRequest, error)
 	DeleteByAccommodation(accommodationId primitive.ObjectID) error
+	GetApprovedEndedBefore(end time.Time) ([]*ReservationRequest, error)
+	UpdateStatus(id primitive.ObjectID, current ReservationRequestStatus, next ReservationRequestStatus) (bool, error)
+	GetPendingExpiredBefore(now time.Time) ([]*ReservationRequest, error)
+	GetPendingToRemindBefore(now time.Time) ([]*ReservationRequest, error)
+	MarkHostReminded(id primitive.ObjectID) (bool, error)
+	CancelReservation(id primitive.ObjectID, refundAmount Money, canceledAt time.Time) (bool, error)
+	RestoreCanceledReservation(id primitive.ObjectID, previous *ReservationRequest) error
+	UpdateDates(id primitive.ObjectID, start time.Time, end time.Time) error
+	AnonymizeClient(clientId string, tombstoneId string, anonymizedAt time.Time) (int64, error)
 }
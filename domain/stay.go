@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// UTC offsets in use range from -12:00 to +14:00.
+	maxZoneOffset = 14 * time.Hour
+	// Check-in and check-out times fall within their day.
+	maxTimeOfDayOffset = 24 * time.Hour
+)
+
+// Date is a calendar date without a time of day or zone.
+type Date struct {
+	Year  int        `bson:"year"`
+	Month time.Month `bson:"month"`
+	Day   int        `bson:"day"`
+}
+
+func DateOf(t time.Time, location *time.Location) Date {
+	year, month, day := t.In(location).Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+func ParseDate(value string) (Date, error) {
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t, time.UTC), nil
+}
+
+func (date Date) At(timeOfDay TimeOfDay, location *time.Location) time.Time {
+	return time.Date(date.Year, date.Month, date.Day, timeOfDay.Hour, timeOfDay.Minute, 0, 0, location)
+}
+
+func (date Date) AddDays(days int) Date {
+	return DateOf(date.At(TimeOfDay{}, time.UTC).AddDate(0, 0, days), time.UTC)
+}
+
+func (date Date) DaysUntil(other Date) int {
+	return int(other.At(TimeOfDay{}, time.UTC).Sub(date.At(TimeOfDay{}, time.UTC)) / (24 * time.Hour))
+}
+
+func (date Date) Before(other Date) bool {
+	return date.DaysUntil(other) > 0
+}
+
+func (date Date) After(other Date) bool {
+	return other.Before(date)
+}
+
+func (date Date) Weekday() time.Weekday {
+	return date.At(TimeOfDay{}, time.UTC).Weekday()
+}
+
+func (date Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", date.Year, date.Month, date.Day)
+}
+
+type TimeOfDay struct {
+	Hour   int `bson:"hour"`
+	Minute int `bson:"minute"`
+}
+
+func ParseTimeOfDay(value string) (TimeOfDay, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return TimeOfDay{}, err
+	}
+	return TimeOfDay{Hour: t.Hour(), Minute: t.Minute()}, nil
+}
+
+func (timeOfDay TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", timeOfDay.Hour, timeOfDay.Minute)
+}
+
+// Stay covers the nights from CheckIn up to, but not including, CheckOut.
+type Stay struct {
+	CheckIn  Date `bson:"check_in"`
+	CheckOut Date `bson:"check_out"`
+}
+
+func (stay Stay) Nights() int {
+	return stay.CheckIn.DaysUntil(stay.CheckOut)
+}
+
+func (stay Stay) Overlaps(other Stay) bool {
+	return stay.CheckIn.Before(other.CheckOut) && other.CheckIn.Before(stay.CheckOut)
+}
+
+func (stay Stay) Contains(night Date) bool {
+	return !night.Before(stay.CheckIn) && night.Before(stay.CheckOut)
+}
+
+// PrefilterWindow widens the stay's UTC dates to a range that holds its instants in any zone at any check-in and
+// check-out time, together with the largest turnover buffer around them, so a range query on stored periods misses no
+// accommodation the stay conflicts with.
+func (stay Stay) PrefilterWindow() (time.Time, time.Time) {
+	margin := maxZoneOffset + maxTimeOfDayOffset + MaxTurnoverBuffer
+	return stay.CheckIn.At(TimeOfDay{}, time.UTC).Add(-margin), stay.CheckOut.At(TimeOfDay{}, time.UTC).Add(margin)
+}
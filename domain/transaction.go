@@ -0,0 +1,12 @@
+package domain
+
+import "context"
+
+type TransactionManager interface {
+	WithTransaction(fn func(transaction Transaction) error) error
+}
+
+type Transaction interface {
+	Context() context.Context
+	Compensate(undo func() error)
+}
@@ -1,8 +1,13 @@
 package domain
 
-import "go.mongodb.org/mongo-driver/bson/primitive"
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
 
 type UnavailabilityStore interface {
+	WithContext(ctx context.Context) UnavailabilityStore
 	Get(id primitive.ObjectID) (*Unavailability, error)
 	Insert(unavailability *Unavailability) error
 	DeleteAll()
@@ -10,8 +15,12 @@ type UnavailabilityStore interface {
 	GetAll() ([]*Unavailability, error)
 	GetPeriod(id primitive.ObjectID) (UnavailabilityPeriod, error)
 	Update(id primitive.ObjectID, unavailability *Unavailability) error
+	UpsertAccommodation(unavailability *Unavailability) error
 	GetUnavailabilityPeriods(id primitive.ObjectID) ([]UnavailabilityPeriod, error)
-	UpdateUnavailabilityPeriods(unavailabilityId primitive.ObjectID, periods []UnavailabilityPeriod) error
+	UpdateUnavailabilityPeriods(unavailabilityId primitive.ObjectID, version int64, periods []UnavailabilityPeriod) error
 	GetByAccommodationId(accommodationId primitive.ObjectID) (*Unavailability, error)
 	GetByHostId(id string) ([]*Unavailability, error)
+	GetByAccommodationIds(accommodationIds []primitive.ObjectID) ([]*Unavailability, error)
+	GetUnavailableAccommodationIds(accommodationIds []primitive.ObjectID, start time.Time, end time.Time) ([]primitive.ObjectID, error)
+	GetWithExternalCalendars() ([]*Unavailability, error)
 }
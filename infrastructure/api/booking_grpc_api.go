@@ -2,14 +2,18 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/ZMS-DevOps/booking-service/application"
 	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
 	pb "github.com/ZMS-DevOps/booking-service/proto"
 	"github.com/ZMS-DevOps/booking-service/util"
 	"github.com/afiskon/promtail-client/promtail"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"time"
 )
 
@@ -39,7 +43,7 @@ func (handler *BookingHandler) AddUnavailability(ctx context.Context, request *p
 		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "AddUnavailability", "")
 		return nil, err
 	}
-	if err := handler.unavailabilityService.AddUnavailability(accommodationId, request.AccommodationName, request.Automatically, request.HostId, span, handler.loki); err != nil {
+	if err := handler.unavailabilityService.AddUnavailability(accommodationId, request.AccommodationName, request.Automatically, request.HostId, time.Duration(request.ResponseWindowHours)*time.Hour, span, handler.loki); err != nil {
 		util.HttpTraceError(err, "failed to add unavailability", span, handler.loki, "AddUnavailability", "")
 		return nil, err
 	}
@@ -56,7 +60,7 @@ func (handler *BookingHandler) EditAccommodation(ctx context.Context, request *p
 		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "EditAccommodation", "")
 		return nil, err
 	}
-	if err := handler.unavailabilityService.UpdateUnavailability(accommodationId, request.AccommodationName, request.Automatically, request.HostId, span, handler.loki); err != nil {
+	if err := handler.unavailabilityService.UpdateUnavailability(accommodationId, request.AccommodationName, request.Automatically, request.HostId, time.Duration(request.ResponseWindowHours)*time.Hour, span, handler.loki); err != nil {
 		util.HttpTraceError(err, "failed to update unavailability", span, handler.loki, "EditAccommodation", "")
 		return nil, err
 	}
@@ -90,8 +94,58 @@ func (handler *BookingHandler) FilterAvailableAccommodation(ctx context.Context,
 		accommodationIDs[i] = id.Hex()
 	}
 
+	response := &pb.FilterAvailableAccommodationResponse{AccommodationIds: accommodationIDs}
+	if request.IncludeAlternatives {
+		alternatives, err := handler.unavailabilityService.SuggestAlternatives(objectIDs, startDate, endDate, span)
+		if err != nil {
+			util.HttpTraceError(err, "failed to suggest alternatives", span, handler.loki, "FilterAvailableAccommodation", "")
+			return nil, err
+		}
+		response.UnavailableAccommodations = mapUnavailableAccommodations(alternatives)
+	}
+
 	util.HttpTraceInfo("Accommodations filtered successfully", span, handler.loki, "FilterAvailableAccommodation", "")
-	return &pb.FilterAvailableAccommodationResponse{AccommodationIds: accommodationIDs}, nil
+	return response, nil
+}
+
+func (handler *BookingHandler) FindFlexibleAvailability(ctx context.Context, request *pb.FindFlexibleAvailabilityRequest) (*pb.FindFlexibleAvailabilityResponse, error) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(ctx, "find-flexible-availability-grpc")
+	defer func() { span.End() }()
+	objectIDs, err := convertHexToObjectIDs(request.AccommodationIds)
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "FindFlexibleAvailability", "")
+		return nil, err
+	}
+
+	windowStart, windowEnd, err := parseDates(request.WindowStart, request.WindowEnd)
+	if err != nil {
+		util.HttpTraceError(err, "failed to parse dates", span, handler.loki, "FindFlexibleAvailability", "")
+		return nil, err
+	}
+
+	availability, err := handler.unavailabilityService.FindFlexibleAvailability(objectIDs, windowStart, windowEnd, int(request.MinNights), int(request.MaxNights), span)
+	if err != nil {
+		util.HttpTraceError(err, "failed to find flexible availability", span, handler.loki, "FindFlexibleAvailability", "")
+		return nil, err
+	}
+
+	accommodations := make([]*pb.FlexibleAvailability, len(availability))
+	for i, accommodation := range availability {
+		stays := make([]*pb.FlexibleStay, len(accommodation.Stays))
+		for j, stay := range accommodation.Stays {
+			stays[j] = &pb.FlexibleStay{
+				StartDate: stay.CheckIn.String(),
+				MaxNights: int32(stay.MaxNights),
+			}
+		}
+		accommodations[i] = &pb.FlexibleAvailability{
+			AccommodationId: accommodation.AccommodationId.Hex(),
+			Stays:           stays,
+		}
+	}
+
+	util.HttpTraceInfo("Flexible availability found successfully", span, handler.loki, "FindFlexibleAvailability", "")
+	return &pb.FindFlexibleAvailabilityResponse{Accommodations: accommodations}, nil
 }
 
 func (handler *BookingHandler) CheckDeleteHost(ctx context.Context, request *pb.CheckDeleteHostRequest) (*pb.CheckDeleteHostResponse, error) {
@@ -111,7 +165,7 @@ func (handler *BookingHandler) CheckDeleteClient(ctx context.Context, request *p
 	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(ctx, "check-delete-client-grpc")
 	defer func() { span.End() }()
 	clientId := request.HostId
-	success := handler.reservationRequestService.DeleteClient(clientId, span, handler.loki)
+	success := handler.reservationRequestService.CanDeleteClient(clientId, span, handler.loki)
 	util.HttpTraceInfo("Check delete client processed successfully", span, handler.loki, "CheckDeleteClient", "")
 	return &pb.CheckDeleteClientResponse{Success: success}, nil
 }
@@ -154,6 +208,132 @@ func (handler *BookingHandler) CheckAccommodationHasReservation(ctx context.Cont
 	return &pb.CheckAccommodationHasReservationResponse{Success: canDelete}, nil
 }
 
+func (handler *BookingHandler) GetPriceQuote(ctx context.Context, request *pb.GetPriceQuoteRequest) (*pb.GetPriceQuoteResponse, error) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(ctx, "get-price-quote-grpc")
+	defer func() { span.End() }()
+	accommodationId, err := primitive.ObjectIDFromHex(request.AccommodationId)
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "GetPriceQuote", "")
+		return nil, err
+	}
+
+	startDate, endDate, err := parseDates(request.StartDate, request.EndDate)
+	if err != nil {
+		util.HttpTraceError(err, "failed to parse dates", span, handler.loki, "GetPriceQuote", "")
+		return nil, err
+	}
+
+	quote, err := handler.unavailabilityService.GetPriceQuote(accommodationId, startDate, endDate, int(request.NumberOfGuests), span, handler.loki)
+	if err != nil {
+		util.HttpTraceError(err, "failed to calculate price quote", span, handler.loki, "GetPriceQuote", "")
+		if errors.Is(err, domain.ErrPricingNotConfigured) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+
+	nights := make([]*pb.NightlyPrice, len(quote.Nights))
+	for i, night := range quote.Nights {
+		nights[i] = &pb.NightlyPrice{
+			Date:  night.Date.String(),
+			Price: mapMoney(night.Price),
+		}
+	}
+
+	util.HttpTraceInfo("Price quote calculated successfully", span, handler.loki, "GetPriceQuote", "")
+	return &pb.GetPriceQuoteResponse{
+		AccommodationId: quote.AccommodationId.Hex(),
+		Nights:          nights,
+		Subtotal:        mapMoney(quote.Subtotal),
+		Discount:        mapMoney(quote.Discount),
+		CleaningFee:     mapMoney(quote.CleaningFee),
+		ServiceFee:      mapMoney(quote.ServiceFee),
+		Total:           mapMoney(quote.Total),
+	}, nil
+}
+
+func (handler *BookingHandler) GetCalendar(ctx context.Context, request *pb.GetCalendarRequest) (*pb.GetCalendarResponse, error) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(ctx, "get-calendar-grpc")
+	defer func() { span.End() }()
+	accommodationId, err := primitive.ObjectIDFromHex(request.AccommodationId)
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "GetCalendar", "")
+		return nil, err
+	}
+
+	from, err := dto.ParseCalendarDate(request.From)
+	if err != nil {
+		util.HttpTraceError(err, "failed to parse from date", span, handler.loki, "GetCalendar", "")
+		return nil, err
+	}
+	to, err := dto.ParseCalendarDate(request.To)
+	if err != nil {
+		util.HttpTraceError(err, "failed to parse to date", span, handler.loki, "GetCalendar", "")
+		return nil, err
+	}
+
+	calendar, err := handler.unavailabilityService.GetCalendar(accommodationId, from, to, span, handler.loki)
+	if err != nil {
+		util.HttpTraceError(err, "failed to get calendar", span, handler.loki, "GetCalendar", "")
+		return nil, err
+	}
+
+	var days []*pb.CalendarDay
+	for _, day := range dto.MapCalendarResponse(calendar) {
+		days = append(days, &pb.CalendarDay{
+			Date:          day.Date,
+			State:         day.State,
+			PeriodId:      day.PeriodId,
+			ReservationId: day.ReservationId,
+			CheckIn:       day.CheckIn,
+			CheckOut:      day.CheckOut,
+		})
+	}
+
+	util.HttpTraceInfo("Calendar fetched successfully", span, handler.loki, "GetCalendar", "")
+	return &pb.GetCalendarResponse{Days: days}, nil
+}
+
+func mapUnavailableAccommodations(alternatives []domain.AvailabilityAlternatives) []*pb.UnavailableAccommodation {
+	var response []*pb.UnavailableAccommodation
+	for _, alternative := range alternatives {
+		var conflictingPeriods []*pb.ConflictingPeriod
+		for _, period := range dto.MapConflictingPeriods(alternative.ConflictingPeriods) {
+			conflictingPeriods = append(conflictingPeriods, &pb.ConflictingPeriod{
+				Id:            period.Id,
+				Start:         period.Start,
+				End:           period.End,
+				Reason:        period.Reason,
+				ReservationId: period.ReservationId,
+			})
+		}
+		response = append(response, &pb.UnavailableAccommodation{
+			AccommodationId:    alternative.AccommodationId.Hex(),
+			ConflictingPeriods: conflictingPeriods,
+			EarlierAlternative: mapDateRange(alternative.Earlier),
+			LaterAlternative:   mapDateRange(alternative.Later),
+		})
+	}
+	return response
+}
+
+func mapDateRange(dateRange *domain.DateRange) *pb.DateRange {
+	if dateRange == nil {
+		return nil
+	}
+	return &pb.DateRange{
+		Start: dateRange.Start.Format(time.RFC3339),
+		End:   dateRange.End.Format(time.RFC3339),
+	}
+}
+
+func mapMoney(money domain.Money) *pb.Money {
+	return &pb.Money{
+		AmountMinor: money.Amount,
+		Currency:    money.Currency,
+	}
+}
+
 func convertHexToObjectIDs(hexIDs []string) ([]primitive.ObjectID, error) {
 	var objectIDs []primitive.ObjectID
 
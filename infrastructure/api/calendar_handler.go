@@ -0,0 +1,112 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/ZMS-DevOps/booking-service/application"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/ical"
+	"github.com/ZMS-DevOps/booking-service/util"
+	"github.com/afiskon/promtail-client/promtail"
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"net/http"
+	"time"
+)
+
+type CalendarHandler struct {
+	service       *application.UnavailabilityService
+	traceProvider *sdktrace.TracerProvider
+	loki          promtail.Client
+}
+
+func NewCalendarHandler(service *application.UnavailabilityService, traceProvider *sdktrace.TracerProvider, loki promtail.Client) *CalendarHandler {
+	server := &CalendarHandler{
+		service:       service,
+		traceProvider: traceProvider,
+		loki:          loki,
+	}
+	return server
+}
+
+func (handler *CalendarHandler) Init(router *mux.Router) {
+	router.HandleFunc("/booking/calendar/{accommodationId}", handler.GetCalendar).Methods("GET")
+	router.HandleFunc("/booking/ical/{accommodationId}.ics", handler.ExportICal).Methods("GET")
+}
+
+func (handler *CalendarHandler) GetCalendar(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "get-calendar-get")
+	defer func() { span.End() }()
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["accommodationId"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "GetCalendar", "")
+		handleError(w, http.StatusBadRequest, "Invalid accommodation id")
+		return
+	}
+
+	from, err := dto.ParseCalendarDate(r.URL.Query().Get("from"))
+	if err != nil {
+		util.HttpTraceError(err, "invalid from parameter", span, handler.loki, "GetCalendar", "")
+		handleError(w, http.StatusBadRequest, "Invalid from parameter")
+		return
+	}
+
+	to, err := dto.ParseCalendarDate(r.URL.Query().Get("to"))
+	if err != nil {
+		util.HttpTraceError(err, "invalid to parameter", span, handler.loki, "GetCalendar", "")
+		handleError(w, http.StatusBadRequest, "Invalid to parameter")
+		return
+	}
+
+	calendar, err := handler.service.GetCalendar(accommodationId, from, to, span, handler.loki)
+	if err != nil {
+		util.HttpTraceError(err, "failed to get calendar", span, handler.loki, "GetCalendar", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dto.MapCalendarResponse(calendar))
+	if err != nil {
+		util.HttpTraceError(err, "failed to marshal data", span, handler.loki, "GetCalendar", "")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Calendar fetched successfully", span, handler.loki, "GetCalendar", "")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonResponse)
+}
+
+// ExportICal serves the accommodation's calendar feed; a missing or wrong token is answered like an unknown accommodation.
+func (handler *CalendarHandler) ExportICal(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "export-ical-get")
+	defer func() { span.End() }()
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["accommodationId"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "ExportICal", "")
+		handleError(w, http.StatusBadRequest, "Invalid accommodation id")
+		return
+	}
+
+	accommodationName, events, err := handler.service.GetICalEvents(accommodationId, r.URL.Query().Get("token"), span, handler.loki)
+	if errors.Is(err, domain.ErrInvalidICalToken) {
+		util.HttpTraceError(err, "invalid calendar token", span, handler.loki, "ExportICal", "")
+		handleError(w, http.StatusNotFound, domain.ErrAccommodationNotFound.Error())
+		return
+	}
+	if err != nil {
+		util.HttpTraceError(err, "failed to export calendar", span, handler.loki, "ExportICal", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Calendar exported successfully", span, handler.loki, "ExportICal", "")
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	w.Write(ical.Encode(accommodationName, events, time.Now()))
+}
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/ZMS-DevOps/booking-service/application"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
+	"github.com/ZMS-DevOps/booking-service/util"
+	"github.com/afiskon/promtail-client/promtail"
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+type ExternalCalendarHandler struct {
+	service       *application.UnavailabilityService
+	traceProvider *sdktrace.TracerProvider
+	loki          promtail.Client
+}
+
+func NewExternalCalendarHandler(service *application.UnavailabilityService, traceProvider *sdktrace.TracerProvider, loki promtail.Client) *ExternalCalendarHandler {
+	server := &ExternalCalendarHandler{
+		service:       service,
+		traceProvider: traceProvider,
+		loki:          loki,
+	}
+	return server
+}
+
+func (handler *ExternalCalendarHandler) Init(router *mux.Router) {
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/external-calendars", handler.GetExternalCalendars).Methods("GET")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/external-calendars", handler.AddExternalCalendar).Methods("POST")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/external-calendars/{calendarId}", handler.RemoveExternalCalendar).Methods("DELETE")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/external-calendars/{calendarId}/sync", handler.SyncExternalCalendar).Methods("POST")
+}
+
+func (handler *ExternalCalendarHandler) GetExternalCalendars(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "get-external-calendars-get")
+	defer func() { span.End() }()
+	accommodationId, err := primitive.ObjectIDFromHex(mux.Vars(r)["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "GetExternalCalendars", "")
+		handleError(w, http.StatusBadRequest, "Invalid accommodation id")
+		return
+	}
+
+	calendars, err := handler.service.GetExternalCalendars(accommodationId, span, handler.loki)
+	if err != nil {
+		util.HttpTraceError(err, "failed to get external calendars", span, handler.loki, "GetExternalCalendars", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dto.MapExternalCalendarResponses(calendars))
+	if err != nil {
+		util.HttpTraceError(err, "failed to marshal data", span, handler.loki, "GetExternalCalendars", "")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("External calendars fetched successfully", span, handler.loki, "GetExternalCalendars", "")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonResponse)
+}
+
+func (handler *ExternalCalendarHandler) AddExternalCalendar(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "add-external-calendar-post")
+	defer func() { span.End() }()
+	accommodationId, err := primitive.ObjectIDFromHex(mux.Vars(r)["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "AddExternalCalendar", "")
+		handleError(w, http.StatusBadRequest, "Invalid accommodation id")
+		return
+	}
+
+	var externalCalendarDto dto.ExternalCalendarDto
+	if err := json.NewDecoder(r.Body).Decode(&externalCalendarDto); err != nil {
+		util.HttpTraceError(err, "invalid request payload", span, handler.loki, "AddExternalCalendar", "")
+		handleError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if err := dto.ValidateExternalCalendarDto(externalCalendarDto); err != nil {
+		util.HttpTraceError(err, "invalid request data", span, handler.loki, "AddExternalCalendar", "")
+		handleError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	calendar := dto.MapExternalCalendar(externalCalendarDto)
+	if err := handler.service.AddExternalCalendar(accommodationId, &calendar, span, handler.loki); err != nil {
+		util.HttpTraceError(err, "failed to add external calendar", span, handler.loki, "AddExternalCalendar", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dto.MapExternalCalendarResponse(calendar))
+	if err != nil {
+		util.HttpTraceError(err, "failed to marshal data", span, handler.loki, "AddExternalCalendar", "")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("External calendar added successfully", span, handler.loki, "AddExternalCalendar", "")
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write(jsonResponse)
+}
+
+func (handler *ExternalCalendarHandler) RemoveExternalCalendar(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "remove-external-calendar-delete")
+	defer func() { span.End() }()
+	accommodationId, calendarId, ok := handler.parseCalendarIds(w, r, span, "RemoveExternalCalendar")
+	if !ok {
+		return
+	}
+
+	if err := handler.service.RemoveExternalCalendar(accommodationId, calendarId, span, handler.loki); err != nil {
+		util.HttpTraceError(err, "failed to remove external calendar", span, handler.loki, "RemoveExternalCalendar", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("External calendar removed successfully", span, handler.loki, "RemoveExternalCalendar", "")
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (handler *ExternalCalendarHandler) SyncExternalCalendar(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "sync-external-calendar-post")
+	defer func() { span.End() }()
+	accommodationId, calendarId, ok := handler.parseCalendarIds(w, r, span, "SyncExternalCalendar")
+	if !ok {
+		return
+	}
+
+	calendar, err := handler.service.SyncExternalCalendar(accommodationId, calendarId, span, handler.loki)
+	if err != nil {
+		util.HttpTraceError(err, "failed to sync external calendar", span, handler.loki, "SyncExternalCalendar", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dto.MapExternalCalendarResponse(*calendar))
+	if err != nil {
+		util.HttpTraceError(err, "failed to marshal data", span, handler.loki, "SyncExternalCalendar", "")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("External calendar synced", span, handler.loki, "SyncExternalCalendar", "")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonResponse)
+}
+
+func (handler *ExternalCalendarHandler) parseCalendarIds(w http.ResponseWriter, r *http.Request, span trace.Span, function string) (primitive.ObjectID, primitive.ObjectID, bool) {
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, function, "")
+		handleError(w, http.StatusBadRequest, "Invalid accommodation id")
+		return primitive.NilObjectID, primitive.NilObjectID, false
+	}
+	calendarId, err := primitive.ObjectIDFromHex(vars["calendarId"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid external calendar id", span, handler.loki, function, "")
+		handleError(w, http.StatusBadRequest, "Invalid external calendar id")
+		return primitive.NilObjectID, primitive.NilObjectID, false
+	}
+	return accommodationId, calendarId, true
+}
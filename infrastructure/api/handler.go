@@ -1,7 +1,38 @@
 package api
 
-import "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+import (
+	"encoding/json"
+	"errors"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"net/http"
+)
 
 type Handler interface {
 	Init(mux *runtime.ServeMux)
 }
+
+func handleServiceError(w http.ResponseWriter, err error, defaultStatusCode int) {
+	var conflictError *domain.ReservationConflictError
+	var validationError *domain.ValidationError
+	switch {
+	case errors.As(err, &conflictError):
+		jsonResponse, marshalErr := json.Marshal(dto.MapReservationConflictResponse(conflictError))
+		if marshalErr != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write(jsonResponse)
+	case errors.As(err, &validationError):
+		handleError(w, http.StatusBadRequest, validationError.Error())
+	case errors.Is(err, domain.ErrAccommodationNotFound), errors.Is(err, domain.ErrRecurringBlockNotFound), errors.Is(err, domain.ErrExternalCalendarNotFound):
+		handleError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrPricingNotConfigured):
+		handleError(w, http.StatusConflict, err.Error())
+	default:
+		w.WriteHeader(defaultStatusCode)
+	}
+}
@@ -0,0 +1,246 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/ZMS-DevOps/booking-service/application"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/dto"
+	"github.com/ZMS-DevOps/booking-service/util"
+	"github.com/afiskon/promtail-client/promtail"
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+type RecurringBlockHandler struct {
+	service       *application.UnavailabilityService
+	traceProvider *sdktrace.TracerProvider
+	loki          promtail.Client
+}
+
+func NewRecurringBlockHandler(service *application.UnavailabilityService, traceProvider *sdktrace.TracerProvider, loki promtail.Client) *RecurringBlockHandler {
+	server := &RecurringBlockHandler{
+		service:       service,
+		traceProvider: traceProvider,
+		loki:          loki,
+	}
+	return server
+}
+
+func (handler *RecurringBlockHandler) Init(router *mux.Router) {
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/recurring-blocks", handler.GetRecurringBlocks).Methods("GET")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/recurring-blocks", handler.AddRecurringBlock).Methods("POST")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/recurring-blocks/{blockId}", handler.UpdateRecurringBlock).Methods("PUT")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/recurring-blocks/{blockId}", handler.DeleteRecurringBlock).Methods("DELETE")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/recurring-blocks/{blockId}/occurrences/{date}", handler.ModifyOccurrence).Methods("PUT")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/recurring-blocks/{blockId}/occurrences/{date}", handler.DeleteOccurrence).Methods("DELETE")
+}
+
+func (handler *RecurringBlockHandler) GetRecurringBlocks(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "get-recurring-blocks-get")
+	defer func() { span.End() }()
+	accommodationId, err := primitive.ObjectIDFromHex(mux.Vars(r)["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "GetRecurringBlocks", "")
+		handleError(w, http.StatusBadRequest, "Invalid accommodation id")
+		return
+	}
+
+	unavailability, err := handler.service.GetByAccommodationId(accommodationId, span, handler.loki)
+	if err != nil || unavailability == nil {
+		util.HttpTraceError(err, "failed to get by accommodation id", span, handler.loki, "GetRecurringBlocks", "")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dto.MapRecurringBlockResponses(unavailability.RecurringBlocks))
+	if err != nil {
+		util.HttpTraceError(err, "failed to marshal data", span, handler.loki, "GetRecurringBlocks", "")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Recurring blocks fetched successfully", span, handler.loki, "GetRecurringBlocks", "")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonResponse)
+}
+
+func (handler *RecurringBlockHandler) AddRecurringBlock(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "add-recurring-block-post")
+	defer func() { span.End() }()
+	accommodationId, err := primitive.ObjectIDFromHex(mux.Vars(r)["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "AddRecurringBlock", "")
+		handleError(w, http.StatusBadRequest, "Invalid accommodation id")
+		return
+	}
+
+	block, ok := handler.decodeRecurringBlock(w, r, span, "AddRecurringBlock")
+	if !ok {
+		return
+	}
+
+	if err := handler.service.AddRecurringBlock(accommodationId, &block, span, handler.loki); err != nil {
+		util.HttpTraceError(err, "failed to add recurring block", span, handler.loki, "AddRecurringBlock", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dto.MapRecurringBlockResponses([]domain.RecurringBlock{block})[0])
+	if err != nil {
+		util.HttpTraceError(err, "failed to marshal data", span, handler.loki, "AddRecurringBlock", "")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Recurring block added successfully", span, handler.loki, "AddRecurringBlock", "")
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write(jsonResponse)
+}
+
+func (handler *RecurringBlockHandler) UpdateRecurringBlock(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "update-recurring-block-put")
+	defer func() { span.End() }()
+	accommodationId, blockId, ok := handler.parseBlockIds(w, r, span, "UpdateRecurringBlock")
+	if !ok {
+		return
+	}
+
+	block, ok := handler.decodeRecurringBlock(w, r, span, "UpdateRecurringBlock")
+	if !ok {
+		return
+	}
+
+	if err := handler.service.UpdateRecurringBlock(accommodationId, blockId, block, span, handler.loki); err != nil {
+		util.HttpTraceError(err, "failed to update recurring block", span, handler.loki, "UpdateRecurringBlock", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Recurring block updated successfully", span, handler.loki, "UpdateRecurringBlock", "")
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (handler *RecurringBlockHandler) DeleteRecurringBlock(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "delete-recurring-block-delete")
+	defer func() { span.End() }()
+	accommodationId, blockId, ok := handler.parseBlockIds(w, r, span, "DeleteRecurringBlock")
+	if !ok {
+		return
+	}
+
+	if err := handler.service.DeleteRecurringBlock(accommodationId, blockId, span, handler.loki); err != nil {
+		util.HttpTraceError(err, "failed to delete recurring block", span, handler.loki, "DeleteRecurringBlock", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Recurring block deleted successfully", span, handler.loki, "DeleteRecurringBlock", "")
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (handler *RecurringBlockHandler) ModifyOccurrence(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "modify-occurrence-put")
+	defer func() { span.End() }()
+	accommodationId, blockId, ok := handler.parseBlockIds(w, r, span, "ModifyOccurrence")
+	if !ok {
+		return
+	}
+	recurrenceId, err := domain.ParseDate(mux.Vars(r)["date"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid occurrence date", span, handler.loki, "ModifyOccurrence", "")
+		handleError(w, http.StatusBadRequest, "Invalid occurrence date")
+		return
+	}
+
+	var occurrenceDto dto.OccurrenceDto
+	if err := json.NewDecoder(r.Body).Decode(&occurrenceDto); err != nil {
+		util.HttpTraceError(err, "invalid request payload", span, handler.loki, "ModifyOccurrence", "")
+		handleError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if err := dto.ValidateOccurrenceDto(occurrenceDto); err != nil {
+		util.HttpTraceError(err, "invalid request data", span, handler.loki, "ModifyOccurrence", "")
+		handleError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	stay, err := dto.MapOccurrence(occurrenceDto)
+	if err != nil {
+		util.HttpTraceError(err, "invalid occurrence dates", span, handler.loki, "ModifyOccurrence", "")
+		handleError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := handler.service.ModifyOccurrence(accommodationId, blockId, recurrenceId, stay, span, handler.loki); err != nil {
+		util.HttpTraceError(err, "failed to modify occurrence", span, handler.loki, "ModifyOccurrence", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Occurrence modified successfully", span, handler.loki, "ModifyOccurrence", "")
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (handler *RecurringBlockHandler) DeleteOccurrence(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "delete-occurrence-delete")
+	defer func() { span.End() }()
+	accommodationId, blockId, ok := handler.parseBlockIds(w, r, span, "DeleteOccurrence")
+	if !ok {
+		return
+	}
+	recurrenceId, err := domain.ParseDate(mux.Vars(r)["date"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid occurrence date", span, handler.loki, "DeleteOccurrence", "")
+		handleError(w, http.StatusBadRequest, "Invalid occurrence date")
+		return
+	}
+
+	if err := handler.service.DeleteOccurrence(accommodationId, blockId, recurrenceId, span, handler.loki); err != nil {
+		util.HttpTraceError(err, "failed to delete occurrence", span, handler.loki, "DeleteOccurrence", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Occurrence deleted successfully", span, handler.loki, "DeleteOccurrence", "")
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (handler *RecurringBlockHandler) parseBlockIds(w http.ResponseWriter, r *http.Request, span trace.Span, function string) (primitive.ObjectID, primitive.ObjectID, bool) {
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, function, "")
+		handleError(w, http.StatusBadRequest, "Invalid accommodation id")
+		return primitive.NilObjectID, primitive.NilObjectID, false
+	}
+	blockId, err := primitive.ObjectIDFromHex(vars["blockId"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid recurring block id", span, handler.loki, function, "")
+		handleError(w, http.StatusBadRequest, "Invalid recurring block id")
+		return primitive.NilObjectID, primitive.NilObjectID, false
+	}
+	return accommodationId, blockId, true
+}
+
+func (handler *RecurringBlockHandler) decodeRecurringBlock(w http.ResponseWriter, r *http.Request, span trace.Span, function string) (domain.RecurringBlock, bool) {
+	var recurringBlockDto dto.RecurringBlockDto
+	if err := json.NewDecoder(r.Body).Decode(&recurringBlockDto); err != nil {
+		util.HttpTraceError(err, "invalid request payload", span, handler.loki, function, "")
+		handleError(w, http.StatusBadRequest, "Invalid request payload")
+		return domain.RecurringBlock{}, false
+	}
+	if err := dto.ValidateRecurringBlockDto(recurringBlockDto); err != nil {
+		util.HttpTraceError(err, "invalid request data", span, handler.loki, function, "")
+		handleError(w, http.StatusBadRequest, err.Error())
+		return domain.RecurringBlock{}, false
+	}
+	block, err := dto.MapRecurringBlock(recurringBlockDto)
+	if err != nil {
+		util.HttpTraceError(err, "invalid recurrence rule", span, handler.loki, function, "")
+		handleError(w, http.StatusBadRequest, err.Error())
+		return domain.RecurringBlock{}, false
+	}
+	return block, true
+}
@@ -58,12 +58,17 @@ func (handler *ReservationRequestHandler) AddRequest(w http.ResponseWriter, r *h
 	}
 	log.Printf("drugi print")
 
-	newReservationRequest := dto.MapRegistrationRequest(addReservationRequestDto)
+	newReservationRequest, err := dto.MapRegistrationRequest(addReservationRequestDto)
+	if err != nil {
+		util.HttpTraceError(err, "invalid price total", span, handler.loki, "AddRequest", "")
+		handleError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	log.Printf("newReservationRequest print %v", newReservationRequest)
 
 	if err := handler.service.AddReservationRequest(newReservationRequest, span, handler.loki); err != nil {
 		util.HttpTraceError(err, "failed to add reservation request", span, handler.loki, "AddRequest", "")
-		w.WriteHeader(http.StatusInternalServerError)
+		handleServiceError(w, err, http.StatusInternalServerError)
 		return
 	}
 
@@ -142,7 +147,8 @@ func (handler *ReservationRequestHandler) Approve(w http.ResponseWriter, r *http
 
 	if err := handler.service.ApproveRequest(reservationRequestId, span, handler.loki); err != nil {
 		util.HttpTraceError(err, "failed to approve request", span, handler.loki, "Approve", "")
-		w.WriteHeader(http.StatusNotFound)
+		handleServiceError(w, err, http.StatusNotFound)
+		return
 	}
 
 	util.HttpTraceInfo("Reservation request approved successfully", span, handler.loki, "Approve", "")
@@ -39,6 +39,19 @@ func (handler *UnavailabilityHandler) Init(router *mux.Router) {
 	router.HandleFunc("/booking/unavailability/host/{id}", handler.GetByHostId).Methods("GET")
 	router.HandleFunc("/booking/unavailability/remove", handler.DeletePeriod).Methods("PUT")
 	router.HandleFunc("/booking/unavailability/add", handler.AddPeriod).Methods("PUT")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/cancellation-policy", handler.GetCancellationPolicy).Methods("GET")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/cancellation-policy", handler.UpdateCancellationPolicy).Methods("PUT")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/pricing", handler.GetPricing).Methods("GET")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/pricing", handler.UpdatePricing).Methods("PUT")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/stay-rules", handler.GetStayRules).Methods("GET")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/stay-rules", handler.UpdateStayRules).Methods("PUT")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/turnover-buffer", handler.GetTurnoverBuffer).Methods("GET")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/turnover-buffer", handler.UpdateTurnoverBuffer).Methods("PUT")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/stay-settings", handler.GetStaySettings).Methods("GET")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/stay-settings", handler.UpdateStaySettings).Methods("PUT")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/ical-token", handler.GetICalToken).Methods("GET")
+	router.HandleFunc("/booking/unavailability/accommodation/{id}/ical-token", handler.RotateICalToken).Methods("POST")
+	router.HandleFunc("/booking/quote", handler.GetPriceQuote).Methods("POST")
 }
 
 func (handler *UnavailabilityHandler) AddPeriod(w http.ResponseWriter, r *http.Request) {
@@ -61,7 +74,7 @@ func (handler *UnavailabilityHandler) AddPeriod(w http.ResponseWriter, r *http.R
 	newUnavailabilityPeriod.Reason = domain.OwnerSet
 	if err := handler.service.AddUnavailabilityPeriod(manageUnavailabilityPeriodDto.AccommodationId, newUnavailabilityPeriod, span, handler.loki); err != nil {
 		util.HttpTraceError(err, "failed to add unavailability period", span, handler.loki, "AddPeriod", "")
-		w.WriteHeader(http.StatusInternalServerError)
+		handleServiceError(w, err, http.StatusInternalServerError)
 		return
 	}
 	util.HttpTraceInfo("Unavailability period added successfully", span, handler.loki, "AddPeriod", "")
@@ -86,7 +99,7 @@ func (handler *UnavailabilityHandler) DeletePeriod(w http.ResponseWriter, r *htt
 	}
 
 	removedUnavailabilityPeriod := dto.MapUnavailabilityPeriod(&manageUnavailabilityPeriodDto)
-	if err := handler.service.RemoveUnavailabilityPeriod(manageUnavailabilityPeriodDto.AccommodationId, removedUnavailabilityPeriod, true, span, handler.loki); err != nil {
+	if err := handler.service.RemoveUnavailabilityPeriod(manageUnavailabilityPeriodDto.AccommodationId, removedUnavailabilityPeriod, span, handler.loki); err != nil {
 		util.HttpTraceError(err, "failed to remove unavailability period", span, handler.loki, "DeletePeriod", "")
 		w.WriteHeader(http.StatusInternalServerError)
 		return
@@ -96,6 +109,436 @@ func (handler *UnavailabilityHandler) DeletePeriod(w http.ResponseWriter, r *htt
 	w.WriteHeader(http.StatusCreated)
 }
 
+func (handler *UnavailabilityHandler) GetCancellationPolicy(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "get-cancellation-policy-get")
+	defer func() { span.End() }()
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "GetCancellationPolicy", "")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	unavailability, err := handler.service.GetByAccommodationId(accommodationId, span, handler.loki)
+	if err != nil || unavailability == nil {
+		util.HttpTraceError(err, "failed to get by accommodation id", span, handler.loki, "GetCancellationPolicy", "")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dto.MapCancellationPolicyDto(unavailability.CancellationPolicy))
+	if err != nil {
+		util.HttpTraceError(err, "failed to marshal data", span, handler.loki, "GetCancellationPolicy", "")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Cancellation policy fetched successfully", span, handler.loki, "GetCancellationPolicy", "")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonResponse)
+}
+
+func (handler *UnavailabilityHandler) UpdateCancellationPolicy(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "update-cancellation-policy-put")
+	defer func() { span.End() }()
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "UpdateCancellationPolicy", "")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var cancellationPolicyDto dto.CancellationPolicyDto
+	if err := json.NewDecoder(r.Body).Decode(&cancellationPolicyDto); err != nil {
+		util.HttpTraceError(err, "invalid request payload", span, handler.loki, "UpdateCancellationPolicy", "")
+		handleError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := dto.ValidateCancellationPolicyDto(cancellationPolicyDto); err != nil {
+		util.HttpTraceError(err, "invalid request data", span, handler.loki, "UpdateCancellationPolicy", "")
+		handleError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	policy := dto.MapCancellationPolicy(cancellationPolicyDto)
+	if err := handler.service.UpdateCancellationPolicy(accommodationId, policy, span, handler.loki); err != nil {
+		util.HttpTraceError(err, "failed to update cancellation policy", span, handler.loki, "UpdateCancellationPolicy", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Cancellation policy updated successfully", span, handler.loki, "UpdateCancellationPolicy", "")
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (handler *UnavailabilityHandler) GetPricing(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "get-pricing-get")
+	defer func() { span.End() }()
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "GetPricing", "")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	pricing, err := handler.service.GetPricing(accommodationId, span, handler.loki)
+	if err != nil {
+		util.HttpTraceError(err, "failed to get pricing by accommodation id", span, handler.loki, "GetPricing", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dto.MapPricingDto(*pricing))
+	if err != nil {
+		util.HttpTraceError(err, "failed to marshal data", span, handler.loki, "GetPricing", "")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Pricing fetched successfully", span, handler.loki, "GetPricing", "")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonResponse)
+}
+
+func (handler *UnavailabilityHandler) UpdatePricing(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "update-pricing-put")
+	defer func() { span.End() }()
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "UpdatePricing", "")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var pricingDto dto.PricingDto
+	if err := json.NewDecoder(r.Body).Decode(&pricingDto); err != nil {
+		util.HttpTraceError(err, "invalid request payload", span, handler.loki, "UpdatePricing", "")
+		handleError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := dto.ValidatePricingDto(pricingDto); err != nil {
+		util.HttpTraceError(err, "invalid request data", span, handler.loki, "UpdatePricing", "")
+		handleError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pricing, err := dto.MapPricing(pricingDto)
+	if err != nil {
+		util.HttpTraceError(err, "invalid pricing", span, handler.loki, "UpdatePricing", "")
+		handleError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := handler.service.UpdatePricing(accommodationId, pricing, span, handler.loki); err != nil {
+		util.HttpTraceError(err, "failed to update pricing", span, handler.loki, "UpdatePricing", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Pricing updated successfully", span, handler.loki, "UpdatePricing", "")
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (handler *UnavailabilityHandler) GetStayRules(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "get-stay-rules-get")
+	defer func() { span.End() }()
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "GetStayRules", "")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	unavailability, err := handler.service.GetByAccommodationId(accommodationId, span, handler.loki)
+	if err != nil || unavailability == nil {
+		util.HttpTraceError(err, "failed to get by accommodation id", span, handler.loki, "GetStayRules", "")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dto.MapStayRulesDto(unavailability.StayRules))
+	if err != nil {
+		util.HttpTraceError(err, "failed to marshal data", span, handler.loki, "GetStayRules", "")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Stay rules fetched successfully", span, handler.loki, "GetStayRules", "")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonResponse)
+}
+
+func (handler *UnavailabilityHandler) UpdateStayRules(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "update-stay-rules-put")
+	defer func() { span.End() }()
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "UpdateStayRules", "")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var stayRulesDto dto.StayRulesDto
+	if err := json.NewDecoder(r.Body).Decode(&stayRulesDto); err != nil {
+		util.HttpTraceError(err, "invalid request payload", span, handler.loki, "UpdateStayRules", "")
+		handleError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := dto.ValidateStayRulesDto(stayRulesDto); err != nil {
+		util.HttpTraceError(err, "invalid request data", span, handler.loki, "UpdateStayRules", "")
+		handleError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := handler.service.UpdateStayRules(accommodationId, dto.MapStayRules(stayRulesDto), span, handler.loki); err != nil {
+		util.HttpTraceError(err, "failed to update stay rules", span, handler.loki, "UpdateStayRules", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Stay rules updated successfully", span, handler.loki, "UpdateStayRules", "")
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (handler *UnavailabilityHandler) GetTurnoverBuffer(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "get-turnover-buffer-get")
+	defer func() { span.End() }()
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "GetTurnoverBuffer", "")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	unavailability, err := handler.service.GetByAccommodationId(accommodationId, span, handler.loki)
+	if err != nil || unavailability == nil {
+		util.HttpTraceError(err, "failed to get by accommodation id", span, handler.loki, "GetTurnoverBuffer", "")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dto.MapTurnoverBufferDto(unavailability.TurnoverBuffer))
+	if err != nil {
+		util.HttpTraceError(err, "failed to marshal data", span, handler.loki, "GetTurnoverBuffer", "")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Turnover buffer fetched successfully", span, handler.loki, "GetTurnoverBuffer", "")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonResponse)
+}
+
+func (handler *UnavailabilityHandler) UpdateTurnoverBuffer(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "update-turnover-buffer-put")
+	defer func() { span.End() }()
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "UpdateTurnoverBuffer", "")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var turnoverBufferDto dto.TurnoverBufferDto
+	if err := json.NewDecoder(r.Body).Decode(&turnoverBufferDto); err != nil {
+		util.HttpTraceError(err, "invalid request payload", span, handler.loki, "UpdateTurnoverBuffer", "")
+		handleError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := dto.ValidateTurnoverBufferDto(turnoverBufferDto); err != nil {
+		util.HttpTraceError(err, "invalid request data", span, handler.loki, "UpdateTurnoverBuffer", "")
+		handleError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := handler.service.UpdateTurnoverBuffer(accommodationId, dto.MapTurnoverBuffer(turnoverBufferDto), span, handler.loki); err != nil {
+		util.HttpTraceError(err, "failed to update turnover buffer", span, handler.loki, "UpdateTurnoverBuffer", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Turnover buffer updated successfully", span, handler.loki, "UpdateTurnoverBuffer", "")
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (handler *UnavailabilityHandler) GetStaySettings(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "get-stay-settings-get")
+	defer func() { span.End() }()
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "GetStaySettings", "")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	unavailability, err := handler.service.GetByAccommodationId(accommodationId, span, handler.loki)
+	if err != nil || unavailability == nil {
+		util.HttpTraceError(err, "failed to get by accommodation id", span, handler.loki, "GetStaySettings", "")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dto.MapStaySettingsDto(unavailability))
+	if err != nil {
+		util.HttpTraceError(err, "failed to marshal data", span, handler.loki, "GetStaySettings", "")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Stay settings fetched successfully", span, handler.loki, "GetStaySettings", "")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonResponse)
+}
+
+func (handler *UnavailabilityHandler) UpdateStaySettings(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "update-stay-settings-put")
+	defer func() { span.End() }()
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "UpdateStaySettings", "")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var staySettingsDto dto.StaySettingsDto
+	if err := json.NewDecoder(r.Body).Decode(&staySettingsDto); err != nil {
+		util.HttpTraceError(err, "invalid request payload", span, handler.loki, "UpdateStaySettings", "")
+		handleError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := dto.ValidateStaySettingsDto(staySettingsDto); err != nil {
+		util.HttpTraceError(err, "invalid request data", span, handler.loki, "UpdateStaySettings", "")
+		handleError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	checkInTime, checkOutTime, err := dto.MapStaySettings(staySettingsDto)
+	if err != nil {
+		util.HttpTraceError(err, "invalid check-in or check-out time", span, handler.loki, "UpdateStaySettings", "")
+		handleError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := handler.service.UpdateStaySettings(accommodationId, staySettingsDto.TimeZone, checkInTime, checkOutTime, span, handler.loki); err != nil {
+		util.HttpTraceError(err, "failed to update stay settings", span, handler.loki, "UpdateStaySettings", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Stay settings updated successfully", span, handler.loki, "UpdateStaySettings", "")
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (handler *UnavailabilityHandler) GetICalToken(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "get-ical-token-get")
+	defer func() { span.End() }()
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "GetICalToken", "")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	unavailability, err := handler.service.GetByAccommodationId(accommodationId, span, handler.loki)
+	if err != nil || unavailability == nil || unavailability.ICalExportToken == "" {
+		util.HttpTraceError(err, "no calendar export token", span, handler.loki, "GetICalToken", "")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dto.MapICalTokenResponse(accommodationId, unavailability.ICalExportToken))
+	if err != nil {
+		util.HttpTraceError(err, "failed to marshal data", span, handler.loki, "GetICalToken", "")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Calendar export token fetched successfully", span, handler.loki, "GetICalToken", "")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonResponse)
+}
+
+func (handler *UnavailabilityHandler) RotateICalToken(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "rotate-ical-token-post")
+	defer func() { span.End() }()
+	vars := mux.Vars(r)
+	accommodationId, err := primitive.ObjectIDFromHex(vars["id"])
+	if err != nil {
+		util.HttpTraceError(err, "invalid accommodation id", span, handler.loki, "RotateICalToken", "")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	token, err := handler.service.RotateICalToken(accommodationId, span, handler.loki)
+	if err != nil {
+		util.HttpTraceError(err, "failed to rotate calendar export token", span, handler.loki, "RotateICalToken", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dto.MapICalTokenResponse(accommodationId, token))
+	if err != nil {
+		util.HttpTraceError(err, "failed to marshal data", span, handler.loki, "RotateICalToken", "")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Calendar export token rotated successfully", span, handler.loki, "RotateICalToken", "")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonResponse)
+}
+
+func (handler *UnavailabilityHandler) GetPriceQuote(w http.ResponseWriter, r *http.Request) {
+	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "get-price-quote-post")
+	defer func() { span.End() }()
+	var priceQuoteRequestDto dto.PriceQuoteRequestDto
+	if err := json.NewDecoder(r.Body).Decode(&priceQuoteRequestDto); err != nil {
+		util.HttpTraceError(err, "invalid request payload", span, handler.loki, "GetPriceQuote", "")
+		handleError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := dto.ValidatePriceQuoteRequestDto(priceQuoteRequestDto); err != nil {
+		util.HttpTraceError(err, "invalid request data", span, handler.loki, "GetPriceQuote", "")
+		handleError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	quote, err := handler.service.GetPriceQuote(priceQuoteRequestDto.AccommodationId, priceQuoteRequestDto.Start, priceQuoteRequestDto.End, priceQuoteRequestDto.NumberOfGuests, span, handler.loki)
+	if err != nil {
+		util.HttpTraceError(err, "failed to calculate price quote", span, handler.loki, "GetPriceQuote", "")
+		handleServiceError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse, err := json.Marshal(dto.MapPriceQuoteResponse(quote))
+	if err != nil {
+		util.HttpTraceError(err, "failed to marshal data", span, handler.loki, "GetPriceQuote", "")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	util.HttpTraceInfo("Price quote calculated successfully", span, handler.loki, "GetPriceQuote", "")
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonResponse)
+}
+
 func (handler *UnavailabilityHandler) GetAll(w http.ResponseWriter, r *http.Request) {
 	_, span := handler.traceProvider.Tracer(domain.ServiceName).Start(r.Context(), "get-all-get")
 	defer func() { span.End() }()
@@ -117,6 +560,7 @@ func (handler *UnavailabilityHandler) GetAll(w http.ResponseWriter, r *http.Requ
 				period.Start,
 				period.End,
 				period.Reason,
+				period.ReservationId,
 			)
 			responseList = append(responseList, response)
 		}
@@ -161,6 +605,7 @@ func (handler *UnavailabilityHandler) GetByAccommodationId(w http.ResponseWriter
 			period.Start,
 			period.End,
 			period.Reason,
+			period.ReservationId,
 		)
 		responseList = append(responseList, response)
 	}
@@ -200,6 +645,7 @@ func (handler *UnavailabilityHandler) GetByHostId(w http.ResponseWriter, r *http
 				period.Start,
 				period.End,
 				period.Reason,
+				period.ReservationId,
 			)
 			responseList = append(responseList, response)
 		}
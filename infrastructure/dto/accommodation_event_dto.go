@@ -0,0 +1,11 @@
+package dto
+
+// AccommodationEventDto is the payload of the accommodation service's accommodation.created, accommodation.updated and
+// accommodation.deleted events; deletions only need the id.
+type AccommodationEventDto struct {
+	Id                  string `json:"id"`
+	AccommodationName   string `json:"accommodation_name"`
+	HostId              string `json:"host_id"`
+	Automatically       bool   `json:"automatically"`
+	ResponseWindowHours int    `json:"response_window_hours"`
+}
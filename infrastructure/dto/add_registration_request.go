@@ -12,10 +12,10 @@ type AddReservationRequestDto struct {
 	AccommodationName string             `json:"accommodation_name"`
 	HostId            string             `json:"host_id"`
 	UserId            string             `json:"user_id"`
-	Start             time.Time          `json:"start"`
-	End               time.Time          `json:"end"`
-	NumberOfGuests    int                `json:"number_of_guests"`
-	PriceTotal        float32            `json:"price_total"`
+	Start             time.Time          `json:"start" validate:"required"`
+	End               time.Time          `json:"end" validate:"required,gtfield=Start"`
+	NumberOfGuests    int                `json:"number_of_guests" validate:"gt=0"`
+	PriceTotal        MoneyDto           `json:"price_total"`
 }
 
 func ValidateAddRegistrationRequestDto(dto AddReservationRequestDto) error {
@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+const calendarDateLayout = "2006-01-02"
+
+type CalendarDayResponse struct {
+	Date          string `json:"date"`
+	State         string `json:"state"`
+	PeriodId      string `json:"period_id,omitempty"`
+	ReservationId string `json:"reservation_id,omitempty"`
+	RecurrenceId  string `json:"recurrence_id,omitempty"`
+	CheckIn       bool   `json:"check_in"`
+	CheckOut      bool   `json:"check_out"`
+}
+
+var calendarDayStates = map[domain.CalendarDayState]string{
+	domain.AvailableDay:        "available",
+	domain.ReservedDay:         "reserved",
+	domain.OwnerBlockedDay:     "owner-blocked",
+	domain.PendingRequestedDay: "pending-requested",
+	domain.ExternalBlockedDay:  "external-blocked",
+}
+
+func MapCalendarResponse(calendar []domain.CalendarDay) []CalendarDayResponse {
+	response := []CalendarDayResponse{}
+	for _, day := range calendar {
+		response = append(response, CalendarDayResponse{
+			Date:          day.Date.String(),
+			State:         calendarDayStates[day.State],
+			PeriodId:      mapOptionalId(day.PeriodId),
+			ReservationId: mapOptionalId(day.ReservationId),
+			RecurrenceId:  mapOptionalDate(day.RecurrenceId),
+			CheckIn:       day.CheckIn,
+			CheckOut:      day.CheckOut,
+		})
+	}
+	return response
+}
+
+func mapOptionalId(id primitive.ObjectID) string {
+	if id.IsZero() {
+		return ""
+	}
+	return id.Hex()
+}
+
+func mapOptionalDate(date *domain.Date) string {
+	if date == nil {
+		return ""
+	}
+	return date.String()
+}
+
+func ParseCalendarDate(value string) (time.Time, error) {
+	if date, err := time.Parse(calendarDateLayout, value); err == nil {
+		return date, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
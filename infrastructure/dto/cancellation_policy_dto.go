@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/go-playground/validator/v10"
+)
+
+type CancellationPolicyDto struct {
+	Type  string          `json:"type" validate:"required,oneof=flexible moderate strict non-refundable custom"`
+	Tiers []RefundTierDto `json:"tiers" validate:"required_if=Type custom,dive"`
+}
+
+type RefundTierDto struct {
+	DaysBeforeStart  int `json:"days_before_start" validate:"gte=0"`
+	RefundPercentage int `json:"refund_percentage" validate:"gte=0,lte=100"`
+}
+
+var cancellationPolicyTypes = map[string]domain.CancellationPolicyType{
+	"flexible":       domain.Flexible,
+	"moderate":       domain.Moderate,
+	"strict":         domain.Strict,
+	"non-refundable": domain.NonRefundable,
+	"custom":         domain.CustomCancellationPolicy,
+}
+
+func ValidateCancellationPolicyDto(dto CancellationPolicyDto) error {
+	validate := validator.New()
+
+	err := validate.Struct(dto)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			fmt.Printf("Field '%s' failed validation with tag '%s'\n", err.Field(), err.Tag())
+		}
+		return err
+	}
+
+	return nil
+}
+
+func MapCancellationPolicy(dto CancellationPolicyDto) domain.CancellationPolicy {
+	var tiers []domain.RefundTier
+	for _, tier := range dto.Tiers {
+		tiers = append(tiers, domain.RefundTier{
+			DaysBeforeStart:  tier.DaysBeforeStart,
+			RefundPercentage: tier.RefundPercentage,
+		})
+	}
+	return domain.CancellationPolicy{
+		Type:  cancellationPolicyTypes[dto.Type],
+		Tiers: tiers,
+	}
+}
+
+func MapCancellationPolicyDto(policy domain.CancellationPolicy) CancellationPolicyDto {
+	response := CancellationPolicyDto{Tiers: []RefundTierDto{}}
+	for name, policyType := range cancellationPolicyTypes {
+		if policyType == policy.Type {
+			response.Type = name
+		}
+	}
+	for _, tier := range policy.Tiers {
+		response.Tiers = append(response.Tiers, RefundTierDto{
+			DaysBeforeStart:  tier.DaysBeforeStart,
+			RefundPercentage: tier.RefundPercentage,
+		})
+	}
+	return response
+}
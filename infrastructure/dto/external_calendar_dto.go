@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/go-playground/validator/v10"
+	"time"
+)
+
+type ExternalCalendarDto struct {
+	Name string `json:"name" validate:"required"`
+	Url  string `json:"url" validate:"required,url"`
+}
+
+type ExternalCalendarResponse struct {
+	Id            string                             `json:"id"`
+	Name          string                             `json:"name"`
+	Url           string                             `json:"url"`
+	LastSyncedAt  string                             `json:"last_synced_at,omitempty"`
+	LastSyncError string                             `json:"last_sync_error,omitempty"`
+	Conflicts     []ExternalCalendarConflictResponse `json:"conflicts"`
+}
+
+type ExternalCalendarConflictResponse struct {
+	UID              string `json:"uid"`
+	CheckIn          string `json:"check_in"`
+	CheckOut         string `json:"check_out"`
+	ReservedPeriodId string `json:"reserved_period_id"`
+	ReservationId    string `json:"reservation_id,omitempty"`
+}
+
+func ValidateExternalCalendarDto(dto ExternalCalendarDto) error {
+	validate := validator.New()
+
+	err := validate.Struct(dto)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			fmt.Printf("Field '%s' failed validation with tag '%s'\n", err.Field(), err.Tag())
+		}
+		return err
+	}
+
+	return nil
+}
+
+func MapExternalCalendar(dto ExternalCalendarDto) domain.ExternalCalendar {
+	return domain.ExternalCalendar{
+		Name: dto.Name,
+		Url:  dto.Url,
+	}
+}
+
+func MapExternalCalendarResponses(calendars []domain.ExternalCalendar) []ExternalCalendarResponse {
+	response := []ExternalCalendarResponse{}
+	for _, calendar := range calendars {
+		response = append(response, MapExternalCalendarResponse(calendar))
+	}
+	return response
+}
+
+func MapExternalCalendarResponse(calendar domain.ExternalCalendar) ExternalCalendarResponse {
+	response := ExternalCalendarResponse{
+		Id:            calendar.Id.Hex(),
+		Name:          calendar.Name,
+		Url:           calendar.Url,
+		LastSyncError: calendar.LastSyncError,
+		Conflicts:     []ExternalCalendarConflictResponse{},
+	}
+	if !calendar.LastSyncedAt.IsZero() {
+		response.LastSyncedAt = calendar.LastSyncedAt.Format(time.RFC3339)
+	}
+	for _, conflict := range calendar.Conflicts {
+		response.Conflicts = append(response.Conflicts, ExternalCalendarConflictResponse{
+			UID:              conflict.UID,
+			CheckIn:          conflict.Stay.CheckIn.String(),
+			CheckOut:         conflict.Stay.CheckOut.String(),
+			ReservedPeriodId: conflict.ReservedPeriodId.Hex(),
+			ReservationId:    mapOptionalId(conflict.ReservationId),
+		})
+	}
+	return response
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"net/url"
+)
+
+type ICalTokenResponse struct {
+	Token   string `json:"token"`
+	FeedUrl string `json:"feed_url"`
+}
+
+func MapICalTokenResponse(accommodationId primitive.ObjectID, token string) ICalTokenResponse {
+	return ICalTokenResponse{
+		Token:   token,
+		FeedUrl: fmt.Sprintf("/booking/ical/%s.ics?token=%s", accommodationId.Hex(), url.QueryEscape(token)),
+	}
+}
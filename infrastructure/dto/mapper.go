@@ -12,7 +12,11 @@ func MapUnavailabilityPeriod(addUnavailabilityPeriodDto *ManageUnavailabilityPer
 	return unavailabilityPeriod
 }
 
-func MapRegistrationRequest(dto AddReservationRequestDto) *domain.ReservationRequest {
+func MapRegistrationRequest(dto AddReservationRequestDto) (*domain.ReservationRequest, error) {
+	priceTotal, err := MapMoney(dto.PriceTotal)
+	if err != nil {
+		return nil, err
+	}
 	return &domain.ReservationRequest{
 		AccommodationId:   dto.AccommodationId,
 		AccommodationName: dto.AccommodationName,
@@ -21,8 +25,8 @@ func MapRegistrationRequest(dto AddReservationRequestDto) *domain.ReservationReq
 		Start:             dto.Start,
 		End:               dto.End,
 		NumberOfGuests:    dto.NumberOfGuests,
-		PriceTotal:        dto.PriceTotal,
-	}
+		PriceTotal:        priceTotal,
+	}, nil
 }
 
 func MapReservationRequestResponse(requests []*domain.ReservationRequest) []*ReservationRequestResponse {
@@ -37,8 +41,9 @@ func MapReservationRequestResponse(requests []*domain.ReservationRequest) []*Res
 			Start:             requests[i].Start,
 			End:               requests[i].End,
 			NumberOfGuests:    requests[i].NumberOfGuests,
-			PriceTotal:        requests[i].PriceTotal,
+			PriceTotal:        MapMoneyDto(requests[i].PriceTotal),
 			Status:            requests[i].Status,
+			RefundAmount:      MapMoneyDto(requests[i].RefundAmount),
 		}
 		response = append(response, &reservationRequest)
 	}
@@ -0,0 +1,20 @@
+package dto
+
+import "github.com/ZMS-DevOps/booking-service/domain"
+
+// Amounts travel as decimal strings so that no precision is lost in JSON numbers.
+type MoneyDto struct {
+	Amount   string `json:"amount" validate:"required,numeric"`
+	Currency string `json:"currency" validate:"required,iso4217"`
+}
+
+func MapMoney(dto MoneyDto) (domain.Money, error) {
+	return domain.ParseMoney(dto.Amount, dto.Currency)
+}
+
+func MapMoneyDto(money domain.Money) MoneyDto {
+	return MoneyDto{
+		Amount:   money.FormatAmount(),
+		Currency: money.Currency,
+	}
+}
@@ -1,7 +1,8 @@
 package dto
 
 type NotificationDTO struct {
-	UserId        string `json:"receiver_id"`
-	ReservationId string `json:"reservation_id"`
-	Status        string `json:"status"`
+	UserId        string    `json:"receiver_id"`
+	ReservationId string    `json:"reservation_id"`
+	Status        string    `json:"status"`
+	RefundAmount  *MoneyDto `json:"refund_amount,omitempty"`
 }
@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/go-playground/validator/v10"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+type PriceQuoteRequestDto struct {
+	AccommodationId primitive.ObjectID `json:"accommodation_id" validate:"required"`
+	Start           time.Time          `json:"start" validate:"required"`
+	End             time.Time          `json:"end" validate:"required,gtfield=Start"`
+	NumberOfGuests  int                `json:"number_of_guests" validate:"gt=0"`
+}
+
+type PriceQuoteResponse struct {
+	AccommodationId primitive.ObjectID     `json:"accommodation_id"`
+	Start           time.Time              `json:"start"`
+	End             time.Time              `json:"end"`
+	NumberOfGuests  int                    `json:"number_of_guests"`
+	Nights          []NightlyPriceResponse `json:"nights"`
+	Subtotal        MoneyDto               `json:"subtotal"`
+	Discount        MoneyDto               `json:"discount"`
+	CleaningFee     MoneyDto               `json:"cleaning_fee"`
+	ServiceFee      MoneyDto               `json:"service_fee"`
+	Total           MoneyDto               `json:"total"`
+}
+
+type NightlyPriceResponse struct {
+	Date  string   `json:"date"`
+	Price MoneyDto `json:"price"`
+}
+
+func ValidatePriceQuoteRequestDto(dto PriceQuoteRequestDto) error {
+	validate := validator.New()
+
+	err := validate.Struct(dto)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			fmt.Printf("Field '%s' failed validation with tag '%s'\n", err.Field(), err.Tag())
+		}
+		return err
+	}
+
+	return nil
+}
+
+func MapPriceQuoteResponse(quote *domain.PriceQuote) PriceQuoteResponse {
+	response := PriceQuoteResponse{
+		AccommodationId: quote.AccommodationId,
+		Start:           quote.Start,
+		End:             quote.End,
+		NumberOfGuests:  quote.NumberOfGuests,
+		Nights:          []NightlyPriceResponse{},
+		Subtotal:        MapMoneyDto(quote.Subtotal),
+		Discount:        MapMoneyDto(quote.Discount),
+		CleaningFee:     MapMoneyDto(quote.CleaningFee),
+		ServiceFee:      MapMoneyDto(quote.ServiceFee),
+		Total:           MapMoneyDto(quote.Total),
+	}
+	for _, night := range quote.Nights {
+		response.Nights = append(response.Nights, NightlyPriceResponse{
+			Date:  night.Date.String(),
+			Price: MapMoneyDto(night.Price),
+		})
+	}
+	return response
+}
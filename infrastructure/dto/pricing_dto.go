@@ -0,0 +1,106 @@
+package dto
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/go-playground/validator/v10"
+	"time"
+)
+
+type PricingDto struct {
+	Type                      string             `json:"type" validate:"required,oneof=per-unit per-guest"`
+	NightlyRate               MoneyDto           `json:"nightly_rate" validate:"required"`
+	Overrides                 []PriceOverrideDto `json:"overrides" validate:"dive"`
+	WeeklyDiscountPercentage  int                `json:"weekly_discount_percentage" validate:"gte=0,lte=100"`
+	MonthlyDiscountPercentage int                `json:"monthly_discount_percentage" validate:"gte=0,lte=100"`
+	CleaningFee               *MoneyDto          `json:"cleaning_fee" validate:"omitempty"`
+	ServiceFeePercentage      int                `json:"service_fee_percentage" validate:"gte=0,lte=100"`
+}
+
+type PriceOverrideDto struct {
+	Start       time.Time `json:"start" validate:"required"`
+	End         time.Time `json:"end" validate:"required,gtfield=Start"`
+	NightlyRate MoneyDto  `json:"nightly_rate" validate:"required"`
+}
+
+var pricingTypes = map[string]domain.PricingType{
+	"per-unit":  domain.PerUnit,
+	"per-guest": domain.PerGuest,
+}
+
+func ValidatePricingDto(dto PricingDto) error {
+	validate := validator.New()
+
+	err := validate.Struct(dto)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			fmt.Printf("Field '%s' failed validation with tag '%s'\n", err.Field(), err.Tag())
+		}
+		return err
+	}
+
+	return nil
+}
+
+func MapPricing(dto PricingDto) (domain.Pricing, error) {
+	nightlyRate, err := MapMoney(dto.NightlyRate)
+	if err != nil {
+		return domain.Pricing{}, err
+	}
+	var overrides []domain.PriceOverride
+	for _, override := range dto.Overrides {
+		overrideNightlyRate, err := MapMoney(override.NightlyRate)
+		if err != nil {
+			return domain.Pricing{}, err
+		}
+		overrides = append(overrides, domain.PriceOverride{
+			Start:       override.Start,
+			End:         override.End,
+			NightlyRate: overrideNightlyRate,
+		})
+	}
+	cleaningFee := domain.Money{Currency: nightlyRate.Currency}
+	if dto.CleaningFee != nil {
+		if cleaningFee, err = MapMoney(*dto.CleaningFee); err != nil {
+			return domain.Pricing{}, err
+		}
+	}
+	if cleaningFee.Currency != nightlyRate.Currency {
+		return domain.Pricing{}, errors.New("cleaning fee currency must match the nightly rate")
+	}
+	return domain.Pricing{
+		Type:                      pricingTypes[dto.Type],
+		NightlyRate:               nightlyRate,
+		Overrides:                 overrides,
+		WeeklyDiscountPercentage:  dto.WeeklyDiscountPercentage,
+		MonthlyDiscountPercentage: dto.MonthlyDiscountPercentage,
+		CleaningFee:               cleaningFee,
+		ServiceFeePercentage:      dto.ServiceFeePercentage,
+	}, nil
+}
+
+func MapPricingDto(pricing domain.Pricing) PricingDto {
+	cleaningFee := MapMoneyDto(pricing.CleaningFee)
+	response := PricingDto{
+		NightlyRate:               MapMoneyDto(pricing.NightlyRate),
+		Overrides:                 []PriceOverrideDto{},
+		WeeklyDiscountPercentage:  pricing.WeeklyDiscountPercentage,
+		MonthlyDiscountPercentage: pricing.MonthlyDiscountPercentage,
+		CleaningFee:               &cleaningFee,
+		ServiceFeePercentage:      pricing.ServiceFeePercentage,
+	}
+	for name, pricingType := range pricingTypes {
+		if pricingType == pricing.Type {
+			response.Type = name
+		}
+	}
+	for _, override := range pricing.Overrides {
+		response.Overrides = append(response.Overrides, PriceOverrideDto{
+			Start:       override.Start,
+			End:         override.End,
+			NightlyRate: MapMoneyDto(override.NightlyRate),
+		})
+	}
+	return response
+}
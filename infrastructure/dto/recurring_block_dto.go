@@ -0,0 +1,124 @@
+package dto
+
+import (
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/go-playground/validator/v10"
+)
+
+type RecurringBlockDto struct {
+	Start      string   `json:"start" validate:"required,datetime=2006-01-02"`
+	Nights     int      `json:"nights" validate:"gte=1"`
+	RRule      string   `json:"rrule" validate:"required"`
+	Exceptions []string `json:"exceptions" validate:"dive,datetime=2006-01-02"`
+}
+
+type OccurrenceDto struct {
+	CheckIn  string `json:"check_in" validate:"required,datetime=2006-01-02"`
+	CheckOut string `json:"check_out" validate:"required,datetime=2006-01-02"`
+}
+
+type RecurringBlockResponse struct {
+	Id                  string                       `json:"id"`
+	Start               string                       `json:"start"`
+	Nights              int                          `json:"nights"`
+	RRule               string                       `json:"rrule"`
+	Exceptions          []string                     `json:"exceptions"`
+	ModifiedOccurrences []ModifiedOccurrenceResponse `json:"modified_occurrences"`
+}
+
+type ModifiedOccurrenceResponse struct {
+	RecurrenceId string `json:"recurrence_id"`
+	CheckIn      string `json:"check_in"`
+	CheckOut     string `json:"check_out"`
+}
+
+func ValidateRecurringBlockDto(dto RecurringBlockDto) error {
+	validate := validator.New()
+
+	err := validate.Struct(dto)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			fmt.Printf("Field '%s' failed validation with tag '%s'\n", err.Field(), err.Tag())
+		}
+		return err
+	}
+
+	return nil
+}
+
+func ValidateOccurrenceDto(dto OccurrenceDto) error {
+	validate := validator.New()
+
+	err := validate.Struct(dto)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			fmt.Printf("Field '%s' failed validation with tag '%s'\n", err.Field(), err.Tag())
+		}
+		return err
+	}
+
+	return nil
+}
+
+func MapRecurringBlock(dto RecurringBlockDto) (domain.RecurringBlock, error) {
+	start, err := domain.ParseDate(dto.Start)
+	if err != nil {
+		return domain.RecurringBlock{}, err
+	}
+	rule, err := domain.ParseRecurrenceRule(dto.RRule)
+	if err != nil {
+		return domain.RecurringBlock{}, err
+	}
+	block := domain.RecurringBlock{
+		Start:  start,
+		Nights: dto.Nights,
+		Rule:   rule,
+	}
+	for _, exception := range dto.Exceptions {
+		date, err := domain.ParseDate(exception)
+		if err != nil {
+			return domain.RecurringBlock{}, err
+		}
+		block.Exceptions = append(block.Exceptions, date)
+	}
+	return block, nil
+}
+
+func MapOccurrence(dto OccurrenceDto) (domain.Stay, error) {
+	checkIn, err := domain.ParseDate(dto.CheckIn)
+	if err != nil {
+		return domain.Stay{}, err
+	}
+	checkOut, err := domain.ParseDate(dto.CheckOut)
+	if err != nil {
+		return domain.Stay{}, err
+	}
+	return domain.Stay{CheckIn: checkIn, CheckOut: checkOut}, nil
+}
+
+func MapRecurringBlockResponses(blocks []domain.RecurringBlock) []RecurringBlockResponse {
+	response := []RecurringBlockResponse{}
+	for _, block := range blocks {
+		blockResponse := RecurringBlockResponse{
+			Id:                  block.Id.Hex(),
+			Start:               block.Start.String(),
+			Nights:              block.Nights,
+			RRule:               block.Rule.String(),
+			Exceptions:          []string{},
+			ModifiedOccurrences: []ModifiedOccurrenceResponse{},
+		}
+		for _, exception := range block.Exceptions {
+			blockResponse.Exceptions = append(blockResponse.Exceptions, exception.String())
+		}
+		for _, modified := range block.ModifiedOccurrences {
+			blockResponse.ModifiedOccurrences = append(blockResponse.ModifiedOccurrences, ModifiedOccurrenceResponse{
+				RecurrenceId: modified.RecurrenceId.String(),
+				CheckIn:      modified.Stay.CheckIn.String(),
+				CheckOut:     modified.Stay.CheckOut.String(),
+			})
+		}
+		response = append(response, blockResponse)
+	}
+	return response
+}
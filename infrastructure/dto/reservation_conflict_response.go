@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"time"
+)
+
+type ReservationConflictResponse struct {
+	Message            string                      `json:"message"`
+	AccommodationId    string                      `json:"accommodation_id"`
+	ConflictingPeriods []ConflictingPeriodResponse `json:"conflicting_periods"`
+}
+
+type ConflictingPeriodResponse struct {
+	Id            string `json:"id"`
+	Start         string `json:"start"`
+	End           string `json:"end"`
+	Reason        string `json:"reason"`
+	ReservationId string `json:"reservation_id,omitempty"`
+	RecurrenceId  string `json:"recurrence_id,omitempty"`
+}
+
+func MapReservationConflictResponse(conflictError *domain.ReservationConflictError) ReservationConflictResponse {
+	return ReservationConflictResponse{
+		Message:            conflictError.Error(),
+		AccommodationId:    conflictError.AccommodationId.Hex(),
+		ConflictingPeriods: MapConflictingPeriods(conflictError.ConflictingPeriods),
+	}
+}
+
+func MapConflictingPeriods(periods []domain.UnavailabilityPeriod) []ConflictingPeriodResponse {
+	response := []ConflictingPeriodResponse{}
+	for _, period := range periods {
+		response = append(response, ConflictingPeriodResponse{
+			Id:            period.Id.Hex(),
+			Start:         period.Start.Format(time.RFC3339),
+			End:           period.End.Format(time.RFC3339),
+			Reason:        unavailabilityReasonToString(period.Reason),
+			ReservationId: mapOptionalId(period.ReservationId),
+			RecurrenceId:  mapOptionalDate(period.RecurrenceId),
+		})
+	}
+	return response
+}
@@ -0,0 +1,191 @@
+package dto
+
+import (
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	pb "github.com/ZMS-DevOps/booking-service/proto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/encoding/protojson"
+	"time"
+)
+
+// EventSchemaVersion is the version of proto/reservation_events.proto that the events below are published under.
+const EventSchemaVersion = 1
+
+const (
+	ReservationRequested       = "reservation.requested"
+	ReservationApproved        = "reservation.approved"
+	ReservationDeclined        = "reservation.declined"
+	ReservationCanceledByGuest = "reservation.canceled-by-guest"
+	ReservationCompleted       = "reservation.completed"
+	ReservationExpired         = "reservation.expired"
+	PeriodBlocked              = "availability.period-blocked"
+	PeriodUnblocked            = "availability.period-unblocked"
+)
+
+var eventMarshalOptions = protojson.MarshalOptions{UseProtoNames: true}
+
+type EventActor struct {
+	role pb.ActorRole
+	id   string
+}
+
+func GuestActor(id string) EventActor {
+	return EventActor{role: pb.ActorRole_ACTOR_ROLE_GUEST, id: id}
+}
+
+func HostActor(id string) EventActor {
+	return EventActor{role: pb.ActorRole_ACTOR_ROLE_HOST, id: id}
+}
+
+var SystemActor = EventActor{role: pb.ActorRole_ACTOR_ROLE_SYSTEM}
+
+// ReservationEvent describes a reservation after the change named by Type. Automatic, DeclinedRequestIds and
+// DaysBeforeCheckIn are only used by the event types that carry them.
+type ReservationEvent struct {
+	Type               string
+	Actor              EventActor
+	Reservation        *domain.ReservationRequest
+	Stay               domain.Stay
+	Automatic          bool
+	DeclinedRequestIds []primitive.ObjectID
+	DaysBeforeCheckIn  int
+}
+
+type AvailabilityEvent struct {
+	Type            string
+	Actor           EventActor
+	AccommodationId primitive.ObjectID
+	HostId          string
+	Period          domain.UnavailabilityPeriod
+	Stay            domain.Stay
+}
+
+func MarshalReservationEvent(id primitive.ObjectID, occurredAt time.Time, event ReservationEvent) ([]byte, error) {
+	message := &pb.ReservationEvent{Metadata: mapEventMetadata(id, event.Type, occurredAt, event.Actor)}
+	snapshot := mapReservationSnapshot(event.Reservation, event.Stay)
+	switch event.Type {
+	case ReservationRequested:
+		message.Event = &pb.ReservationEvent_Requested{Requested: &pb.ReservationRequested{Reservation: snapshot, AutomaticReview: event.Automatic}}
+	case ReservationApproved:
+		var declinedRequestIds []string
+		for _, declinedRequestId := range event.DeclinedRequestIds {
+			declinedRequestIds = append(declinedRequestIds, declinedRequestId.Hex())
+		}
+		message.Event = &pb.ReservationEvent_Approved{Approved: &pb.ReservationApproved{Reservation: snapshot, Automatic: event.Automatic, DeclinedRequestIds: declinedRequestIds}}
+	case ReservationDeclined:
+		message.Event = &pb.ReservationEvent_Declined{Declined: &pb.ReservationDeclined{Reservation: snapshot}}
+	case ReservationCanceledByGuest:
+		message.Event = &pb.ReservationEvent_CanceledByGuest{CanceledByGuest: &pb.ReservationCanceledByGuest{
+			Reservation:       snapshot,
+			RefundAmount:      mapEventMoney(event.Reservation.RefundAmount),
+			DaysBeforeCheckIn: int32(event.DaysBeforeCheckIn),
+		}}
+	case ReservationCompleted:
+		message.Event = &pb.ReservationEvent_Completed{Completed: &pb.ReservationCompleted{Reservation: snapshot}}
+	case ReservationExpired:
+		message.Event = &pb.ReservationEvent_Expired{Expired: &pb.ReservationExpired{Reservation: snapshot}}
+	default:
+		return nil, fmt.Errorf("unknown reservation event type %q", event.Type)
+	}
+	return eventMarshalOptions.Marshal(message)
+}
+
+func MarshalAvailabilityEvent(id primitive.ObjectID, occurredAt time.Time, event AvailabilityEvent) ([]byte, error) {
+	message := &pb.AvailabilityEvent{Metadata: mapEventMetadata(id, event.Type, occurredAt, event.Actor)}
+	period := mapBlockedPeriod(event)
+	switch event.Type {
+	case PeriodBlocked:
+		message.Event = &pb.AvailabilityEvent_Blocked{Blocked: &pb.PeriodBlocked{Period: period}}
+	case PeriodUnblocked:
+		message.Event = &pb.AvailabilityEvent_Unblocked{Unblocked: &pb.PeriodUnblocked{Period: period}}
+	default:
+		return nil, fmt.Errorf("unknown availability event type %q", event.Type)
+	}
+	return eventMarshalOptions.Marshal(message)
+}
+
+func mapEventMetadata(id primitive.ObjectID, eventType string, occurredAt time.Time, actor EventActor) *pb.EventMetadata {
+	return &pb.EventMetadata{
+		EventId:       id.Hex(),
+		EventType:     eventType,
+		SchemaVersion: EventSchemaVersion,
+		OccurredAt:    formatEventTime(occurredAt),
+		Actor:         &pb.Actor{Role: actor.role, Id: actor.id},
+	}
+}
+
+func mapReservationSnapshot(request *domain.ReservationRequest, stay domain.Stay) *pb.ReservationSnapshot {
+	snapshot := &pb.ReservationSnapshot{
+		ReservationId:     request.Id.Hex(),
+		AccommodationId:   request.AccommodationId.Hex(),
+		AccommodationName: request.AccommodationName,
+		HostId:            request.HostId,
+		GuestId:           request.UserId,
+		CheckInDate:       stay.CheckIn.String(),
+		CheckOutDate:      stay.CheckOut.String(),
+		Start:             formatEventTime(request.Start),
+		End:               formatEventTime(request.End),
+		NumberOfGuests:    int32(request.NumberOfGuests),
+		PriceTotal:        mapEventMoney(request.PriceTotal),
+		Status:            mapReservationStatus(request.Status),
+		ExpiresAt:         formatEventTime(request.ExpiresAt),
+		CanceledAt:        formatEventTime(request.CanceledAt),
+	}
+	if request.RefundAmount.Currency != "" {
+		snapshot.RefundAmount = mapEventMoney(request.RefundAmount)
+	}
+	return snapshot
+}
+
+func mapBlockedPeriod(event AvailabilityEvent) *pb.BlockedPeriod {
+	period := &pb.BlockedPeriod{
+		AccommodationId: event.AccommodationId.Hex(),
+		HostId:          event.HostId,
+		CheckInDate:     event.Stay.CheckIn.String(),
+		CheckOutDate:    event.Stay.CheckOut.String(),
+		Start:           formatEventTime(event.Period.Start),
+		End:             formatEventTime(event.Period.End),
+		Reason:          pb.BlockReason_BLOCK_REASON_OWNER,
+	}
+	if !event.Period.Id.IsZero() {
+		period.PeriodId = event.Period.Id.Hex()
+	}
+	if event.Period.Reason == domain.Reserved {
+		period.Reason = pb.BlockReason_BLOCK_REASON_RESERVED
+		period.ReservationId = event.Period.ReservationId.Hex()
+	}
+	return period
+}
+
+func mapReservationStatus(status domain.ReservationRequestStatus) pb.ReservationStatus {
+	switch status {
+	case domain.Pending:
+		return pb.ReservationStatus_RESERVATION_STATUS_PENDING
+	case domain.Approved:
+		return pb.ReservationStatus_RESERVATION_STATUS_APPROVED
+	case domain.DeclinedByUser:
+		return pb.ReservationStatus_RESERVATION_STATUS_CANCELED_BY_GUEST
+	case domain.DeclinedByHost:
+		return pb.ReservationStatus_RESERVATION_STATUS_DECLINED_BY_HOST
+	case domain.Completed:
+		return pb.ReservationStatus_RESERVATION_STATUS_COMPLETED
+	case domain.Expired:
+		return pb.ReservationStatus_RESERVATION_STATUS_EXPIRED
+	}
+	return pb.ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+}
+
+func mapEventMoney(money domain.Money) *pb.Money {
+	return &pb.Money{
+		AmountMinor: money.Amount,
+		Currency:    money.Currency,
+	}
+}
+
+func formatEventTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
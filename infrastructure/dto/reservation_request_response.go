@@ -14,7 +14,8 @@ type ReservationRequestResponse struct {
 	Start                        time.Time                       `json:"start"`
 	End                          time.Time                       `json:"end"`
 	NumberOfGuests               int                             `json:"number_of_guests"`
-	PriceTotal                   float32                         `json:"price_total"`
+	PriceTotal                   MoneyDto                        `json:"price_total"`
 	Status                       domain.ReservationRequestStatus `json:"status"`
 	NumberOfCanceledReservations int                             `json:"number_of_canceled_reservations"`
+	RefundAmount                 MoneyDto                        `json:"refund_amount"`
 }
@@ -0,0 +1,96 @@
+package dto
+
+import (
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/go-playground/validator/v10"
+	"strings"
+	"time"
+)
+
+type StayRulesDto struct {
+	MinNights   int                   `json:"min_nights" validate:"gte=0"`
+	MaxNights   int                   `json:"max_nights" validate:"gte=0"`
+	CheckInDays []string              `json:"check_in_days" validate:"dive,oneof=sunday monday tuesday wednesday thursday friday saturday"`
+	Overrides   []StayRuleOverrideDto `json:"overrides" validate:"dive"`
+}
+
+type StayRuleOverrideDto struct {
+	Start       time.Time `json:"start" validate:"required"`
+	End         time.Time `json:"end" validate:"required,gtfield=Start"`
+	MinNights   int       `json:"min_nights" validate:"gte=0"`
+	MaxNights   int       `json:"max_nights" validate:"gte=0"`
+	CheckInDays []string  `json:"check_in_days" validate:"dive,oneof=sunday monday tuesday wednesday thursday friday saturday"`
+}
+
+func ValidateStayRulesDto(dto StayRulesDto) error {
+	validate := validator.New()
+
+	err := validate.Struct(dto)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			fmt.Printf("Field '%s' failed validation with tag '%s'\n", err.Field(), err.Tag())
+		}
+		return err
+	}
+
+	return nil
+}
+
+func MapStayRules(dto StayRulesDto) domain.StayRules {
+	var overrides []domain.StayRuleOverride
+	for _, override := range dto.Overrides {
+		overrides = append(overrides, domain.StayRuleOverride{
+			Start:       override.Start,
+			End:         override.End,
+			MinNights:   override.MinNights,
+			MaxNights:   override.MaxNights,
+			CheckInDays: mapWeekdays(override.CheckInDays),
+		})
+	}
+	return domain.StayRules{
+		MinNights:   dto.MinNights,
+		MaxNights:   dto.MaxNights,
+		CheckInDays: mapWeekdays(dto.CheckInDays),
+		Overrides:   overrides,
+	}
+}
+
+func MapStayRulesDto(rules domain.StayRules) StayRulesDto {
+	response := StayRulesDto{
+		MinNights:   rules.MinNights,
+		MaxNights:   rules.MaxNights,
+		CheckInDays: mapWeekdayNames(rules.CheckInDays),
+		Overrides:   []StayRuleOverrideDto{},
+	}
+	for _, override := range rules.Overrides {
+		response.Overrides = append(response.Overrides, StayRuleOverrideDto{
+			Start:       override.Start,
+			End:         override.End,
+			MinNights:   override.MinNights,
+			MaxNights:   override.MaxNights,
+			CheckInDays: mapWeekdayNames(override.CheckInDays),
+		})
+	}
+	return response
+}
+
+func mapWeekdays(names []string) []time.Weekday {
+	var weekdays []time.Weekday
+	for _, name := range names {
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if strings.EqualFold(day.String(), name) {
+				weekdays = append(weekdays, day)
+			}
+		}
+	}
+	return weekdays
+}
+
+func mapWeekdayNames(weekdays []time.Weekday) []string {
+	names := []string{}
+	for _, day := range weekdays {
+		names = append(names, strings.ToLower(day.String()))
+	}
+	return names
+}
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/go-playground/validator/v10"
+)
+
+type StaySettingsDto struct {
+	TimeZone     string `json:"time_zone" validate:"required,timezone"`
+	CheckInTime  string `json:"check_in_time" validate:"required,datetime=15:04"`
+	CheckOutTime string `json:"check_out_time" validate:"required,datetime=15:04"`
+}
+
+func ValidateStaySettingsDto(dto StaySettingsDto) error {
+	validate := validator.New()
+
+	err := validate.Struct(dto)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			fmt.Printf("Field '%s' failed validation with tag '%s'\n", err.Field(), err.Tag())
+		}
+		return err
+	}
+
+	return nil
+}
+
+func MapStaySettings(dto StaySettingsDto) (domain.TimeOfDay, domain.TimeOfDay, error) {
+	checkInTime, err := domain.ParseTimeOfDay(dto.CheckInTime)
+	if err != nil {
+		return domain.TimeOfDay{}, domain.TimeOfDay{}, err
+	}
+	checkOutTime, err := domain.ParseTimeOfDay(dto.CheckOutTime)
+	if err != nil {
+		return domain.TimeOfDay{}, domain.TimeOfDay{}, err
+	}
+	return checkInTime, checkOutTime, nil
+}
+
+// Accommodations that were never configured report the defaults they are evaluated with.
+func MapStaySettingsDto(unavailability *domain.Unavailability) StaySettingsDto {
+	settings := StaySettingsDto{
+		TimeZone:     "UTC",
+		CheckInTime:  domain.DefaultCheckInTime.String(),
+		CheckOutTime: domain.DefaultCheckOutTime.String(),
+	}
+	if unavailability.TimeZone != "" {
+		settings.TimeZone = unavailability.TimeZone
+	}
+	if unavailability.CheckInTime != nil {
+		settings.CheckInTime = unavailability.CheckInTime.String()
+	}
+	if unavailability.CheckOutTime != nil {
+		settings.CheckOutTime = unavailability.CheckOutTime.String()
+	}
+	return settings
+}
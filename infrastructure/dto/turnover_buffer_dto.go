@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/go-playground/validator/v10"
+	"time"
+)
+
+type TurnoverBufferDto struct {
+	BeforeHours int `json:"before_hours" validate:"gte=0,lte=168"`
+	AfterHours  int `json:"after_hours" validate:"gte=0,lte=168"`
+}
+
+func ValidateTurnoverBufferDto(dto TurnoverBufferDto) error {
+	validate := validator.New()
+
+	err := validate.Struct(dto)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			fmt.Printf("Field '%s' failed validation with tag '%s'\n", err.Field(), err.Tag())
+		}
+		return err
+	}
+
+	return nil
+}
+
+func MapTurnoverBuffer(dto TurnoverBufferDto) domain.TurnoverBuffer {
+	return domain.TurnoverBuffer{
+		Before: time.Duration(dto.BeforeHours) * time.Hour,
+		After:  time.Duration(dto.AfterHours) * time.Hour,
+	}
+}
+
+func MapTurnoverBufferDto(buffer domain.TurnoverBuffer) TurnoverBufferDto {
+	return TurnoverBufferDto{
+		BeforeHours: int(buffer.Before / time.Hour),
+		AfterHours:  int(buffer.After / time.Hour),
+	}
+}
@@ -13,10 +13,11 @@ type UnavailabilityResponse struct {
 	Start             string `json:"start"`
 	End               string `json:"end"`
 	Reason            string `json:"reason"`
+	ReservationId     string `json:"reservation_id,omitempty"`
 }
 
-func MapToUnavailabilityResponse(id primitive.ObjectID, accommodationId primitive.ObjectID, accommodationName string, start time.Time, end time.Time, reason domain.UnavailabilityReason) UnavailabilityResponse {
-	return UnavailabilityResponse{
+func MapToUnavailabilityResponse(id primitive.ObjectID, accommodationId primitive.ObjectID, accommodationName string, start time.Time, end time.Time, reason domain.UnavailabilityReason, reservationId primitive.ObjectID) UnavailabilityResponse {
+	response := UnavailabilityResponse{
 		Id:                id.Hex(),
 		AccommodationId:   accommodationId.Hex(),
 		AccommodationName: accommodationName,
@@ -24,6 +25,10 @@ func MapToUnavailabilityResponse(id primitive.ObjectID, accommodationId primitiv
 		End:               end.Format(time.RFC3339),
 		Reason:            unavailabilityReasonToString(reason),
 	}
+	if !reservationId.IsZero() {
+		response.ReservationId = reservationId.Hex()
+	}
+	return response
 }
 
 func unavailabilityReasonToString(reason domain.UnavailabilityReason) string {
@@ -32,6 +37,8 @@ func unavailabilityReasonToString(reason domain.UnavailabilityReason) string {
 		return "Reserved"
 	case domain.OwnerSet:
 		return "OwnerSet"
+	case domain.External:
+		return "External"
 	default:
 		return "Unknown"
 	}
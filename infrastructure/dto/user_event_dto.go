@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+// UserDeletedEventDto is the payload of the user service's user.deleted event.
+type UserDeletedEventDto struct {
+	Id string `json:"id"`
+}
+
+// UserAnonymizedDto confirms to the account deletion saga that the user's reservations were anonymized.
+type UserAnonymizedDto struct {
+	UserId                 string    `json:"user_id"`
+	DeletionEventId        string    `json:"deletion_event_id"`
+	AnonymizedReservations int64     `json:"anonymized_reservations"`
+	AnonymizedAt           time.Time `json:"anonymized_at"`
+}
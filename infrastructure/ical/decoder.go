@@ -0,0 +1,247 @@
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405"
+	statusCanceled = "CANCELLED"
+)
+
+var durationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+type eventBuilder struct {
+	event    domain.CalendarEvent
+	hasStart bool
+	hasEnd   bool
+	duration int
+	canceled bool
+}
+
+// Decode reads the VEVENTs of an RFC 5545 calendar as all-day events. Date-time values are taken on the date they
+// are written in, so a feed in the accommodation's own timezone maps onto its nights; UTC values use the UTC date.
+// Canceled events are dropped, and canceled occurrences of a series become exceptions of it.
+func Decode(reader io.Reader) ([]domain.CalendarEvent, error) {
+	lines, err := unfoldLines(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []domain.CalendarEvent
+	var canceledOccurrences []domain.CalendarEvent
+	var current *eventBuilder
+	var nested []string
+	isCalendar := false
+	for _, line := range lines {
+		prop, err := parseProperty(line)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case prop.name == "BEGIN" && current == nil && strings.EqualFold(prop.value, "VCALENDAR"):
+			isCalendar = true
+		case prop.name == "BEGIN" && current == nil && strings.EqualFold(prop.value, "VEVENT"):
+			current = &eventBuilder{}
+		case prop.name == "BEGIN" && current != nil:
+			nested = append(nested, strings.ToUpper(prop.value))
+		case prop.name == "END" && len(nested) > 0:
+			nested = nested[:len(nested)-1]
+		case prop.name == "END" && current != nil && strings.EqualFold(prop.value, "VEVENT"):
+			event, err := current.build()
+			if err != nil {
+				return nil, err
+			}
+			if current.canceled && event.RecurrenceId != nil {
+				canceledOccurrences = append(canceledOccurrences, event)
+			} else if !current.canceled {
+				events = append(events, event)
+			}
+			current = nil
+		case current != nil && len(nested) == 0:
+			if err := current.set(prop); err != nil {
+				return nil, fmt.Errorf("invalid %s in event %q: %w", prop.name, current.event.UID, err)
+			}
+		}
+	}
+
+	// Anything else, such as an error page served with a 200, would otherwise read as a calendar without events.
+	if !isCalendar {
+		return nil, fmt.Errorf("feed is not an iCalendar")
+	}
+	if current != nil {
+		return nil, fmt.Errorf("event %q is not terminated", current.event.UID)
+	}
+
+	for _, canceled := range canceledOccurrences {
+		for i := range events {
+			if events[i].UID == canceled.UID && events[i].Rule != nil {
+				events[i].Exceptions = append(events[i].Exceptions, *canceled.RecurrenceId)
+			}
+		}
+	}
+	return events, nil
+}
+
+func (builder *eventBuilder) set(prop property) error {
+	switch prop.name {
+	case "UID":
+		builder.event.UID = prop.value
+	case "SUMMARY":
+		builder.event.Summary = unescapeText(prop.value)
+	case "STATUS":
+		builder.canceled = strings.EqualFold(prop.value, statusCanceled)
+	case "DTSTART":
+		date, err := parseDateValue(prop)
+		if err != nil {
+			return err
+		}
+		builder.event.Stay.CheckIn, builder.hasStart = date, true
+	case "DTEND":
+		date, err := parseDateValue(prop)
+		if err != nil {
+			return err
+		}
+		builder.event.Stay.CheckOut, builder.hasEnd = date, true
+	case "DURATION":
+		days, err := parseDurationDays(prop.value)
+		if err != nil {
+			return err
+		}
+		builder.duration = days
+	case "RRULE":
+		rule, err := domain.ParseRecurrenceRule(prop.value)
+		if err != nil {
+			return err
+		}
+		builder.event.Rule = &rule
+	case "EXDATE":
+		for _, value := range strings.Split(prop.value, ",") {
+			date, err := parseDateValue(property{params: prop.params, value: value})
+			if err != nil {
+				return err
+			}
+			builder.event.Exceptions = append(builder.event.Exceptions, date)
+		}
+	case "RECURRENCE-ID":
+		date, err := parseDateValue(prop)
+		if err != nil {
+			return err
+		}
+		builder.event.RecurrenceId = &date
+	}
+	return nil
+}
+
+// An event without an end lasts its duration, or a single night.
+func (builder *eventBuilder) build() (domain.CalendarEvent, error) {
+	event := builder.event
+	if event.UID == "" {
+		return event, fmt.Errorf("event without UID")
+	}
+	if !builder.hasStart {
+		return event, fmt.Errorf("event %q has no start", event.UID)
+	}
+	if !builder.hasEnd {
+		event.Stay.CheckOut = event.Stay.CheckIn.AddDays(builder.duration)
+	}
+	if !event.Stay.CheckOut.After(event.Stay.CheckIn) {
+		event.Stay.CheckOut = event.Stay.CheckIn.AddDays(1)
+	}
+	return event, nil
+}
+
+func unfoldLines(reader io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// parseProperty splits "NAME;PARAM=value:VALUE", keeping colons and semicolons inside quoted parameter values.
+func parseProperty(line string) (property, error) {
+	quoted := false
+	separator := -1
+	for i, char := range line {
+		if char == '"' {
+			quoted = !quoted
+		} else if char == ':' && !quoted {
+			separator = i
+			break
+		}
+	}
+	if separator < 0 {
+		return property{}, fmt.Errorf("invalid content line %q", line)
+	}
+
+	parts := strings.Split(line[:separator], ";")
+	prop := property{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string),
+		value:  line[separator+1:],
+	}
+	for _, param := range parts[1:] {
+		if key, value, ok := strings.Cut(param, "="); ok {
+			prop.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+		}
+	}
+	return prop, nil
+}
+
+func parseDateValue(prop property) (domain.Date, error) {
+	value := strings.TrimSpace(prop.value)
+	if prop.params["VALUE"] == "DATE" || len(value) == len(dateLayout) {
+		date, err := time.Parse(dateLayout, value)
+		if err != nil {
+			return domain.Date{}, err
+		}
+		return domain.DateOf(date, time.UTC), nil
+	}
+
+	dateTime, err := time.Parse(dateTimeLayout, strings.TrimSuffix(value, "Z"))
+	if err != nil {
+		return domain.Date{}, err
+	}
+	return domain.DateOf(dateTime, time.UTC), nil
+}
+
+// Only whole days count towards a stay; a duration shorter than a day yields none.
+func parseDurationDays(value string) (int, error) {
+	match := durationPattern.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil || match[1] == "-" {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	weeks, _ := strconv.Atoi(match[2])
+	days, _ := strconv.Atoi(match[3])
+	hours, _ := strconv.Atoi(match[4])
+	return weeks*7 + days + hours/24, nil
+}
+
+func unescapeText(value string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(value)
+}
@@ -0,0 +1,82 @@
+package ical
+
+import (
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"strings"
+	"time"
+)
+
+const (
+	productId       = "-//ZMS-DevOps//booking-service//EN"
+	maxLineOctets   = 75
+	dateValueLayout = "%04d%02d%02d"
+)
+
+// Encode renders events as an RFC 5545 calendar of all-day events, with CRLF line endings and folded long lines.
+func Encode(calendarName string, events []domain.CalendarEvent, now time.Time) []byte {
+	var builder strings.Builder
+	writeLine(&builder, "BEGIN:VCALENDAR")
+	writeLine(&builder, "VERSION:2.0")
+	writeLine(&builder, "PRODID:"+productId)
+	writeLine(&builder, "CALSCALE:GREGORIAN")
+	writeLine(&builder, "METHOD:PUBLISH")
+	writeLine(&builder, "X-WR-CALNAME:"+escapeText(calendarName))
+
+	timestamp := now.UTC().Format("20060102T150405Z")
+	for _, event := range events {
+		writeLine(&builder, "BEGIN:VEVENT")
+		writeLine(&builder, "UID:"+escapeText(event.UID))
+		writeLine(&builder, "DTSTAMP:"+timestamp)
+		writeLine(&builder, "DTSTART;VALUE=DATE:"+formatDate(event.Stay.CheckIn))
+		writeLine(&builder, "DTEND;VALUE=DATE:"+formatDate(event.Stay.CheckOut))
+		if event.RecurrenceId != nil {
+			writeLine(&builder, "RECURRENCE-ID;VALUE=DATE:"+formatDate(*event.RecurrenceId))
+		}
+		if event.Rule != nil {
+			writeLine(&builder, "RRULE:"+event.Rule.String())
+		}
+		if len(event.Exceptions) > 0 {
+			var exceptions []string
+			for _, exception := range event.Exceptions {
+				exceptions = append(exceptions, formatDate(exception))
+			}
+			writeLine(&builder, "EXDATE;VALUE=DATE:"+strings.Join(exceptions, ","))
+		}
+		writeLine(&builder, "SUMMARY:"+escapeText(event.Summary))
+		writeLine(&builder, "TRANSP:OPAQUE")
+		writeLine(&builder, "END:VEVENT")
+	}
+
+	writeLine(&builder, "END:VCALENDAR")
+	return []byte(builder.String())
+}
+
+func formatDate(date domain.Date) string {
+	return fmt.Sprintf(dateValueLayout, date.Year, date.Month, date.Day)
+}
+
+func escapeText(value string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(value)
+}
+
+// Lines longer than 75 octets continue on the next line after a single space, without splitting a UTF-8 sequence.
+func writeLine(builder *strings.Builder, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		builder.WriteString(line[:cut])
+		builder.WriteString("\r\n ")
+		line = line[cut:]
+		limit = maxLineOctets - 1
+	}
+	builder.WriteString(line)
+	builder.WriteString("\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
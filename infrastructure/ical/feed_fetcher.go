@@ -0,0 +1,93 @@
+package ical
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const maxFeedBytes = 5 << 20
+
+var errPrivateAddress = errors.New("calendar feed resolves to a private address")
+
+type HttpFeedFetcher struct {
+	client *http.Client
+}
+
+// NewHttpFeedFetcher returns a fetcher that refuses loopback, private and link-local addresses unless
+// allowPrivateNetworks is set, since feed URLs are supplied by hosts.
+func NewHttpFeedFetcher(timeout time.Duration, allowPrivateNetworks bool) domain.CalendarFeedFetcher {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivateNetworks {
+		dialer.Control = rejectPrivateAddresses
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &HttpFeedFetcher{
+		client: &http.Client{Timeout: timeout, Transport: transport},
+	}
+}
+
+// Fetch downloads and decodes a feed; webcal URLs are fetched over https. Given the ETag of an earlier fetch, it asks
+// for the feed only if it has changed since.
+func (fetcher *HttpFeedFetcher) Fetch(url string, etag string) (*domain.CalendarFeed, error) {
+	if strings.HasPrefix(strings.ToLower(url), "webcal://") {
+		url = "https://" + url[len("webcal://"):]
+	}
+
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "text/calendar")
+	request.Header.Set("User-Agent", domain.ServiceName)
+	if etag != "" {
+		request.Header.Set("If-None-Match", etag)
+	}
+
+	response, err := fetcher.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotModified && etag != "" {
+		return &domain.CalendarFeed{ETag: etag, NotModified: true}, nil
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("calendar feed returned status %d", response.StatusCode)
+	}
+	// A truncated feed would drop events and unblock their dates, so oversized feeds are rejected instead.
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxFeedBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxFeedBytes {
+		return nil, fmt.Errorf("calendar feed exceeds %d bytes", maxFeedBytes)
+	}
+	events, err := Decode(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	return &domain.CalendarFeed{Events: events, ETag: response.Header.Get("ETag")}, nil
+}
+
+func rejectPrivateAddresses(_ string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+		return errPrivateAddress
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"log"
+	"time"
+)
+
+const (
+	pollTimeout       = time.Second
+	retryBackoff      = 5 * time.Second
+	traceParentHeader = "traceparent"
+	traceStateHeader  = "tracestate"
+)
+
+// KafkaEventConsumer hands messages to a handler one at a time and commits a message's offset only once it has been
+// handled, so a crash redelivers it. Offsets must not be auto-committed.
+type KafkaEventConsumer struct {
+	consumer *kafka.Consumer
+	topics   []string
+	handler  domain.EventHandler
+}
+
+func NewKafkaEventConsumer(consumer *kafka.Consumer, topics []string, handler domain.EventHandler) *KafkaEventConsumer {
+	return &KafkaEventConsumer{
+		consumer: consumer,
+		topics:   topics,
+		handler:  handler,
+	}
+}
+
+func (consumer *KafkaEventConsumer) Start(ctx context.Context) {
+	defer consumer.consumer.Close()
+	if err := consumer.consumer.SubscribeTopics(consumer.topics, nil); err != nil {
+		log.Printf("failed to subscribe to %v: %v", consumer.topics, err)
+		return
+	}
+
+	for ctx.Err() == nil {
+		message, err := consumer.consumer.ReadMessage(pollTimeout)
+		if err != nil {
+			var kafkaErr kafka.Error
+			if !errors.As(err, &kafkaErr) || !kafkaErr.IsTimeout() {
+				log.Printf("failed to read message: %v", err)
+			}
+			continue
+		}
+		if !consumer.handle(ctx, message) {
+			return
+		}
+		if _, err := consumer.consumer.CommitMessage(message); err != nil {
+			log.Printf("failed to commit offset %v: %v", message.TopicPartition, err)
+		}
+	}
+}
+
+// handle retries a message until it is handled, keeping later messages of its partition behind it. Messages that can
+// never be handled are skipped. It reports false if ctx was canceled first.
+func (consumer *KafkaEventConsumer) handle(ctx context.Context, message *kafka.Message) bool {
+	event := mapKafkaEvent(message)
+	for {
+		err := consumer.handler.Handle(event)
+		if err == nil {
+			return true
+		}
+		var validationError *domain.ValidationError
+		if errors.As(err, &validationError) {
+			log.Printf("skipping event %s from %v: %v", event.Id, message.TopicPartition, err)
+			return true
+		}
+
+		log.Printf("failed to handle event %s from %v, retrying: %v", event.Id, message.TopicPartition, err)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(retryBackoff):
+		}
+	}
+}
+
+// mapKafkaEvent reads CloudEvents binary-mode headers when present. Without an event id, the message's position
+// identifies it, which stays the same across redeliveries.
+func mapKafkaEvent(message *kafka.Message) domain.Event {
+	event := domain.Event{
+		Topic:     *message.TopicPartition.Topic,
+		Key:       string(message.Key),
+		Payload:   message.Value,
+		Partition: message.TopicPartition.Partition,
+		Offset:    int64(message.TopicPartition.Offset),
+		Time:      message.Timestamp,
+	}
+	for _, header := range message.Headers {
+		switch header.Key {
+		case "ce_id":
+			event.Id = string(header.Value)
+		case messageIdHeader:
+			if event.Id == "" {
+				event.Id = string(header.Value)
+			}
+		case "ce_type":
+			event.Type = string(header.Value)
+		case "ce_time":
+			if t, err := time.Parse(time.RFC3339Nano, string(header.Value)); err == nil {
+				event.Time = t
+			}
+		case traceParentHeader, traceStateHeader:
+			if event.TraceContext == nil {
+				event.TraceContext = make(map[string]string)
+			}
+			event.TraceContext[header.Key] = string(header.Value)
+		}
+	}
+	if event.Id == "" {
+		event.Id = fmt.Sprintf("%s/%d/%d", event.Topic, event.Partition, event.Offset)
+	}
+	if event.Type == "" {
+		event.Type = event.Topic
+	}
+	return event
+}
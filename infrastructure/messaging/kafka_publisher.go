@@ -0,0 +1,74 @@
+package messaging
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"time"
+)
+
+const (
+	deliveryTimeout    = 10 * time.Second
+	messageIdHeader    = "message-id"
+	cloudEventsVersion = "1.0"
+	contentType        = "application/json"
+)
+
+var errDeliveryTimeout = errors.New("timed out waiting for delivery report")
+
+type KafkaEventPublisher struct {
+	producer *kafka.Producer
+}
+
+func NewKafkaEventPublisher(producer *kafka.Producer) domain.EventPublisher {
+	return &KafkaEventPublisher{
+		producer: producer,
+	}
+}
+
+// Publish returns once Kafka has acknowledged the event, so a nil error means it was stored by the broker.
+func (publisher *KafkaEventPublisher) Publish(event domain.Event) error {
+	deliveries := make(chan kafka.Event, 1)
+	err := publisher.producer.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &event.Topic, Partition: kafka.PartitionAny},
+		Key:            []byte(event.Key),
+		Value:          event.Payload,
+		Headers:        getHeaders(event),
+	}, deliveries)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case delivered := <-deliveries:
+		switch delivery := delivered.(type) {
+		case *kafka.Message:
+			return delivery.TopicPartition.Error
+		case kafka.Error:
+			return delivery
+		default:
+			return fmt.Errorf("unexpected delivery event %v", delivered)
+		}
+	case <-time.After(deliveryTimeout):
+		return errDeliveryTimeout
+	}
+}
+
+// getHeaders maps an event onto a CloudEvents 1.0 binary-mode Kafka message, with its trace context as W3C headers.
+// The message-id header is kept for consumers that deduplicate by it.
+func getHeaders(event domain.Event) []kafka.Header {
+	headers := []kafka.Header{
+		{Key: "ce_specversion", Value: []byte(cloudEventsVersion)},
+		{Key: "ce_id", Value: []byte(event.Id)},
+		{Key: "ce_source", Value: []byte(domain.ServiceName)},
+		{Key: "ce_type", Value: []byte(event.Type)},
+		{Key: "ce_time", Value: []byte(event.Time.UTC().Format(time.RFC3339Nano))},
+		{Key: "content-type", Value: []byte(contentType)},
+		{Key: messageIdHeader, Value: []byte(event.Id)},
+	}
+	for key, value := range event.TraceContext {
+		headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+	}
+	return headers
+}
@@ -0,0 +1,18 @@
+package messaging
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"log"
+)
+
+// LogEventPublisher only logs events, for local development.
+type LogEventPublisher struct{}
+
+func NewLogEventPublisher() domain.EventPublisher {
+	return &LogEventPublisher{}
+}
+
+func (publisher *LogEventPublisher) Publish(event domain.Event) error {
+	log.Printf("event %s of type %s published to %s with key %q (trace %s): %s", event.Id, event.Type, event.Topic, event.Key, event.TraceContext["traceparent"], event.Payload)
+	return nil
+}
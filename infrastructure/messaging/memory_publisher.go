@@ -0,0 +1,36 @@
+package messaging
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"sync"
+)
+
+// InMemoryEventPublisher records published events instead of sending them, for tests and local runs without a broker.
+type InMemoryEventPublisher struct {
+	mutex  sync.Mutex
+	events []domain.Event
+}
+
+func NewInMemoryEventPublisher() *InMemoryEventPublisher {
+	return &InMemoryEventPublisher{}
+}
+
+func (publisher *InMemoryEventPublisher) Publish(event domain.Event) error {
+	publisher.mutex.Lock()
+	defer publisher.mutex.Unlock()
+	publisher.events = append(publisher.events, event)
+	return nil
+}
+
+// Events returns the events published so far, oldest first.
+func (publisher *InMemoryEventPublisher) Events() []domain.Event {
+	publisher.mutex.Lock()
+	defer publisher.mutex.Unlock()
+	return append([]domain.Event{}, publisher.events...)
+}
+
+func (publisher *InMemoryEventPublisher) Reset() {
+	publisher.mutex.Lock()
+	defer publisher.mutex.Unlock()
+	publisher.events = nil
+}
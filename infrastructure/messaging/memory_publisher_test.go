@@ -0,0 +1,37 @@
+package messaging
+
+import (
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"testing"
+)
+
+func TestInMemoryEventPublisherRecordsEventsInOrder(t *testing.T) {
+	publisher := NewInMemoryEventPublisher()
+	for _, id := range []string{"first", "second"} {
+		if err := publisher.Publish(domain.Event{Id: id, Topic: "booking.reservation.v1", Key: "key"}); err != nil {
+			t.Fatalf("publishing %s failed: %v", id, err)
+		}
+	}
+
+	events := publisher.Events()
+	if len(events) != 2 || events[0].Id != "first" || events[1].Id != "second" {
+		t.Fatalf("recorded %v, want first and second in order", events)
+	}
+	events[0].Id = "changed"
+	if publisher.Events()[0].Id != "first" {
+		t.Error("changing the returned events changed the recorded ones")
+	}
+}
+
+func TestInMemoryEventPublisherResetForgetsEvents(t *testing.T) {
+	publisher := NewInMemoryEventPublisher()
+	if err := publisher.Publish(domain.Event{Id: "first"}); err != nil {
+		t.Fatal(err)
+	}
+
+	publisher.Reset()
+
+	if events := publisher.Events(); len(events) != 0 {
+		t.Errorf("recorded %v after reset, want none", events)
+	}
+}
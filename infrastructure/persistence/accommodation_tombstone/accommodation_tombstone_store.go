@@ -0,0 +1,74 @@
+package accommodation_tombstone
+
+import (
+	"context"
+	"errors"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+const (
+	DATABASE   = "bookingdb"
+	COLLECTION = "accommodation_tombstones"
+)
+
+// Late events are redeliveries or stragglers from the topics, so tombstones only need to outlive the topics' retention.
+const tombstoneRetention = 30 * 24 * time.Hour
+
+type AccommodationTombstoneMongoDBStore struct {
+	tombstones *mongo.Collection
+	ctx        context.Context
+}
+
+func NewAccommodationTombstoneMongoDBStore(client *mongo.Client) domain.AccommodationTombstoneStore {
+	tombstones := client.Database(DATABASE).Collection(COLLECTION)
+	return &AccommodationTombstoneMongoDBStore{
+		tombstones: tombstones,
+		ctx:        context.TODO(),
+	}
+}
+
+func EnsureIndexes(client *mongo.Client) error {
+	tombstones := client.Database(DATABASE).Collection(COLLECTION)
+	_, err := tombstones.Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "deleted_at", Value: 1}},
+		Options: options.Index().SetName("deleted_at_ttl").SetExpireAfterSeconds(int32(tombstoneRetention.Seconds())),
+	})
+	return err
+}
+
+func (store *AccommodationTombstoneMongoDBStore) WithContext(ctx context.Context) domain.AccommodationTombstoneStore {
+	return &AccommodationTombstoneMongoDBStore{
+		tombstones: store.tombstones,
+		ctx:        ctx,
+	}
+}
+
+func (store *AccommodationTombstoneMongoDBStore) Get(accommodationId primitive.ObjectID) (*domain.AccommodationTombstone, error) {
+	var tombstone domain.AccommodationTombstone
+	err := store.tombstones.FindOne(store.ctx, bson.M{"_id": accommodationId}).Decode(&tombstone)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &tombstone, nil
+}
+
+// Upsert keeps the latest deletion time when an accommodation's deletion is received more than once.
+func (store *AccommodationTombstoneMongoDBStore) Upsert(tombstone *domain.AccommodationTombstone) error {
+	filter := bson.M{"_id": tombstone.AccommodationId}
+	update := bson.M{"$max": bson.M{"deleted_at": tombstone.DeletedAt}}
+	_, err := store.tombstones.UpdateOne(store.ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+func (store *AccommodationTombstoneMongoDBStore) Delete(accommodationId primitive.ObjectID) error {
+	_, err := store.tombstones.DeleteOne(store.ctx, bson.M{"_id": accommodationId})
+	return err
+}
@@ -0,0 +1,54 @@
+package lease
+
+import (
+	"context"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+const (
+	DATABASE   = "bookingdb"
+	COLLECTION = "lease"
+)
+
+type LeaseMongoDBStore struct {
+	leases *mongo.Collection
+}
+
+func NewLeaseMongoDBStore(client *mongo.Client) domain.LeaseStore {
+	leases := client.Database(DATABASE).Collection(COLLECTION)
+	return &LeaseMongoDBStore{
+		leases: leases,
+	}
+}
+
+// Acquire takes over the named lease when it is free, expired or already held by owner.
+// A concurrent upsert from another replica fails on the _id index, which means the lease is taken.
+func (store *LeaseMongoDBStore) Acquire(name string, owner string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	filter := bson.M{
+		"_id": name,
+		"$or": []bson.M{
+			{"owner": owner},
+			{"expires_at": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"owner":      owner,
+			"expires_at": now.Add(ttl),
+		},
+	}
+
+	_, err := store.leases.UpdateOne(context.TODO(), filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,171 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+const (
+	DATABASE   = "bookingdb"
+	COLLECTION = "outbox"
+)
+
+const deliveredRetention = 7 * 24 * time.Hour
+
+type OutboxMongoDBStore struct {
+	messages *mongo.Collection
+	ctx      context.Context
+}
+
+func NewOutboxMongoDBStore(client *mongo.Client) domain.OutboxStore {
+	messages := client.Database(DATABASE).Collection(COLLECTION)
+	return &OutboxMongoDBStore{
+		messages: messages,
+		ctx:      context.TODO(),
+	}
+}
+
+// EnsureIndexes also creates the collection, which older servers cannot do inside a transaction. Delivered messages
+// expire after a week; undelivered ones have no delivered_at and are never removed.
+func EnsureIndexes(client *mongo.Client) error {
+	messages := client.Database(DATABASE).Collection(COLLECTION)
+	_, err := messages.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "delivered_at", Value: 1}, {Key: "next_attempt_at", Value: 1}, {Key: "created_at", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "delivered_at", Value: 1}, {Key: "key", Value: 1}, {Key: "created_at", Value: 1}},
+		},
+		{
+			Keys:    bson.D{{Key: "delivered_at", Value: 1}},
+			Options: options.Index().SetName("delivered_at_ttl").SetExpireAfterSeconds(int32(deliveredRetention.Seconds())),
+		},
+	})
+	return err
+}
+
+func (store *OutboxMongoDBStore) WithContext(ctx context.Context) domain.OutboxStore {
+	return &OutboxMongoDBStore{
+		messages: store.messages,
+		ctx:      ctx,
+	}
+}
+
+func (store *OutboxMongoDBStore) Insert(message *domain.OutboxMessage) error {
+	_, err := store.messages.InsertOne(store.ctx, message)
+	return err
+}
+
+func (store *OutboxMongoDBStore) Delete(id primitive.ObjectID) error {
+	_, err := store.messages.DeleteOne(store.ctx, bson.M{"_id": id})
+	return err
+}
+
+// GetDue returns undelivered messages whose next attempt is due, oldest first. Messages recorded after an undelivered
+// message with the same key that is waiting for its next attempt are held back behind it. Created times only have
+// millisecond precision, so one recorded in the same millisecond is held back as well.
+func (store *OutboxMongoDBStore) GetDue(now time.Time, limit int) ([]*domain.OutboxMessage, error) {
+	waitingKeys, err := store.getWaitingKeys(now)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{
+		"delivered_at":    nil,
+		"next_attempt_at": bson.M{"$lte": now},
+	}
+	if len(waitingKeys) > 0 {
+		var heldBack bson.A
+		for key, waitingSince := range waitingKeys {
+			heldBack = append(heldBack, bson.M{"key": key, "created_at": bson.M{"$gte": waitingSince}})
+		}
+		filter["$nor"] = heldBack
+	}
+	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}).SetLimit(int64(limit))
+	cursor, err := store.messages.Find(store.ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(store.ctx)
+
+	var messages []*domain.OutboxMessage
+	if err := cursor.All(store.ctx, &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// getWaitingKeys returns, for each key with an undelivered message that is not due yet, when the oldest such message
+// was recorded.
+func (store *OutboxMongoDBStore) getWaitingKeys(now time.Time) (map[string]time.Time, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"delivered_at": nil, "next_attempt_at": bson.M{"$gt": now}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$key", "since": bson.M{"$min": "$created_at"}}}},
+	}
+	cursor, err := store.messages.Aggregate(store.ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(store.ctx)
+
+	waitingKeys := make(map[string]time.Time)
+	for cursor.Next(store.ctx) {
+		var result struct {
+			Key   string    `bson:"_id"`
+			Since time.Time `bson:"since"`
+		}
+		if err := cursor.Decode(&result); err != nil {
+			return nil, err
+		}
+		waitingKeys[result.Key] = result.Since
+	}
+	return waitingKeys, cursor.Err()
+}
+
+func (store *OutboxMongoDBStore) MarkDelivered(id primitive.ObjectID, deliveredAt time.Time) error {
+	update := bson.M{
+		"$set":   bson.M{"delivered_at": deliveredAt},
+		"$unset": bson.M{"last_error": ""},
+		"$inc":   bson.M{"attempts": 1},
+	}
+	_, err := store.messages.UpdateOne(store.ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+func (store *OutboxMongoDBStore) MarkFailed(id primitive.ObjectID, nextAttemptAt time.Time, lastError string) error {
+	update := bson.M{
+		"$set": bson.M{
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      lastError,
+		},
+		"$inc": bson.M{"attempts": 1},
+	}
+	_, err := store.messages.UpdateOne(store.ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+// GetBacklog returns the number of undelivered messages and when the oldest of them was recorded.
+func (store *OutboxMongoDBStore) GetBacklog() (int64, time.Time, error) {
+	filter := bson.M{"delivered_at": nil}
+	count, err := store.messages.CountDocuments(store.ctx, filter)
+	if err != nil || count == 0 {
+		return count, time.Time{}, err
+	}
+
+	var oldest domain.OutboxMessage
+	findOptions := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	err = store.messages.FindOne(store.ctx, filter, findOptions).Decode(&oldest)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, time.Time{}, nil
+	}
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return count, oldest.CreatedAt, nil
+}
@@ -0,0 +1,67 @@
+package processed_event
+
+import (
+	"context"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+const (
+	DATABASE   = "bookingdb"
+	COLLECTION = "processed_events"
+)
+
+// Redeliveries happen within minutes of the original, so records only need to outlive the topics' retention.
+const processedRetention = 30 * 24 * time.Hour
+
+type ProcessedEventMongoDBStore struct {
+	events *mongo.Collection
+	ctx    context.Context
+}
+
+func NewProcessedEventMongoDBStore(client *mongo.Client) domain.ProcessedEventStore {
+	events := client.Database(DATABASE).Collection(COLLECTION)
+	return &ProcessedEventMongoDBStore{
+		events: events,
+		ctx:    context.TODO(),
+	}
+}
+
+func EnsureIndexes(client *mongo.Client) error {
+	events := client.Database(DATABASE).Collection(COLLECTION)
+	_, err := events.Indexes().CreateOne(context.TODO(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "processed_at", Value: 1}},
+		Options: options.Index().SetName("processed_at_ttl").SetExpireAfterSeconds(int32(processedRetention.Seconds())),
+	})
+	return err
+}
+
+func (store *ProcessedEventMongoDBStore) WithContext(ctx context.Context) domain.ProcessedEventStore {
+	return &ProcessedEventMongoDBStore{
+		events: store.events,
+		ctx:    ctx,
+	}
+}
+
+func (store *ProcessedEventMongoDBStore) Exists(consumer string, eventId string) (bool, error) {
+	count, err := store.events.CountDocuments(store.ctx, bson.M{"_id": getProcessedEventId(consumer, eventId)}, options.Count().SetLimit(1))
+	return count > 0, err
+}
+
+func (store *ProcessedEventMongoDBStore) Insert(event *domain.ProcessedEvent) error {
+	event.Id = getProcessedEventId(event.Consumer, event.EventId)
+	_, err := store.events.InsertOne(store.ctx, event)
+	return err
+}
+
+func (store *ProcessedEventMongoDBStore) Delete(consumer string, eventId string) error {
+	_, err := store.events.DeleteOne(store.ctx, bson.M{"_id": getProcessedEventId(consumer, eventId)})
+	return err
+}
+
+func getProcessedEventId(consumer string, eventId string) string {
+	return consumer + "/" + eventId
+}
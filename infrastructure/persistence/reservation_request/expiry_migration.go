@@ -0,0 +1,75 @@
+package reservation_request
+
+import (
+	"context"
+	"errors"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/persistence/unavailability"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"log"
+	"time"
+)
+
+// MigrateExpiryFields gives pending requests stored before requests expired an expiry and a reminder time. Requests have
+// no creation time of their own, so the window runs from the creation time in their ObjectID.
+func MigrateExpiryFields(client *mongo.Client) error {
+	reservationRequests := client.Database(DATABASE).Collection(COLLECTION)
+	accommodations := client.Database(unavailability.DATABASE).Collection(unavailability.COLLECTION)
+
+	filter := bson.M{
+		"status":     domain.Pending,
+		"expires_at": bson.M{"$exists": false},
+	}
+	cursor, err := reservationRequests.Find(context.TODO(), filter)
+	if err != nil {
+		return err
+	}
+	var requests []*domain.ReservationRequest
+	if err := cursor.All(context.TODO(), &requests); err != nil {
+		return err
+	}
+
+	responseWindows := make(map[primitive.ObjectID]time.Duration)
+	for _, request := range requests {
+		responseWindow, ok := responseWindows[request.AccommodationId]
+		if !ok {
+			responseWindow, err = getResponseWindow(accommodations, request.AccommodationId)
+			if err != nil {
+				return err
+			}
+			responseWindows[request.AccommodationId] = responseWindow
+		}
+
+		createdAt := request.Id.Timestamp()
+		update := bson.M{
+			"$set": bson.M{
+				"expires_at":    createdAt.Add(responseWindow),
+				"remind_at":     createdAt.Add(responseWindow / 2),
+				"host_reminded": false,
+			},
+		}
+		if _, err := reservationRequests.UpdateOne(context.TODO(), bson.M{"_id": request.Id, "expires_at": bson.M{"$exists": false}}, update); err != nil {
+			return err
+		}
+	}
+	if len(requests) > 0 {
+		log.Printf("migrated expiry of %d pending reservation requests", len(requests))
+	}
+	return nil
+}
+
+func getResponseWindow(accommodations *mongo.Collection, accommodationId primitive.ObjectID) (time.Duration, error) {
+	var accommodation domain.Unavailability
+	findOptions := options.FindOne().SetProjection(bson.M{"reservation_request_response_window": 1})
+	err := accommodations.FindOne(context.TODO(), bson.M{"accommodation_id": accommodationId}, findOptions).Decode(&accommodation)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, err
+	}
+	if accommodation.ReservationRequestResponseWindow <= 0 {
+		return domain.DefaultReservationRequestResponseWindow, nil
+	}
+	return accommodation.ReservationRequestResponseWindow, nil
+}
@@ -0,0 +1,41 @@
+package reservation_request
+
+import (
+	"context"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"log"
+	"math"
+)
+
+var moneyFields = []string{"price_total", "refund_amount"}
+
+// MigrateMoneyFields converts amounts stored as plain floats into minor units of the given currency.
+func MigrateMoneyFields(client *mongo.Client, currency string) error {
+	reservationRequests := client.Database(DATABASE).Collection(COLLECTION)
+	factor := math.Pow10(domain.CurrencyExponent(currency))
+
+	for _, field := range moneyFields {
+		filter := bson.M{field: bson.M{"$type": "number"}}
+		update := mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{
+				field: bson.M{
+					"amount": bson.M{"$toLong": bson.M{"$round": bson.A{
+						bson.M{"$multiply": bson.A{"$" + field, factor}}, 0,
+					}}},
+					"currency": currency,
+				},
+			}}},
+		}
+
+		result, err := reservationRequests.UpdateMany(context.TODO(), filter, update)
+		if err != nil {
+			return err
+		}
+		if result.ModifiedCount > 0 {
+			log.Printf("migrated %s of %d reservation requests to %s", field, result.ModifiedCount, currency)
+		}
+	}
+	return nil
+}
@@ -17,12 +17,21 @@ const (
 
 type ReservationRequestMongoDBStore struct {
 	reservationRequestCollection *mongo.Collection
+	ctx                          context.Context
 }
 
 func NewReservationRequestMongoDBStore(client *mongo.Client) domain.ReservationRequestStore {
 	reservationRequestCollection := client.Database(DATABASE).Collection(COLLECTION)
 	return &ReservationRequestMongoDBStore{
 		reservationRequestCollection: reservationRequestCollection,
+		ctx:                          context.TODO(),
+	}
+}
+
+func (store *ReservationRequestMongoDBStore) WithContext(ctx context.Context) domain.ReservationRequestStore {
+	return &ReservationRequestMongoDBStore{
+		reservationRequestCollection: store.reservationRequestCollection,
+		ctx:                          ctx,
 	}
 }
 
@@ -32,12 +41,12 @@ func (store *ReservationRequestMongoDBStore) Get(id primitive.ObjectID) (*domain
 }
 
 func (store *ReservationRequestMongoDBStore) DeleteAll() {
-	store.reservationRequestCollection.DeleteMany(context.TODO(), bson.D{{}})
+	store.reservationRequestCollection.DeleteMany(store.ctx, bson.D{{}})
 }
 
 func (store *ReservationRequestMongoDBStore) Insert(reservationRequest *domain.ReservationRequest) (*primitive.ObjectID, error) {
 	reservationRequest.Id = primitive.NewObjectID()
-	result, err := store.reservationRequestCollection.InsertOne(context.TODO(), reservationRequest)
+	result, err := store.reservationRequestCollection.InsertOne(store.ctx, reservationRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -51,14 +60,14 @@ func (store *ReservationRequestMongoDBStore) GetAll() ([]*domain.ReservationRequ
 }
 
 func (store *ReservationRequestMongoDBStore) filterOne(filter interface{}) (reservationRequest *domain.ReservationRequest, err error) {
-	result := store.reservationRequestCollection.FindOne(context.TODO(), filter)
+	result := store.reservationRequestCollection.FindOne(store.ctx, filter)
 	err = result.Decode(&reservationRequest)
 	return
 }
 
 func (store *ReservationRequestMongoDBStore) filter(filter interface{}) ([]*domain.ReservationRequest, error) {
-	cursor, err := store.reservationRequestCollection.Find(context.TODO(), filter)
-	defer cursor.Close(context.TODO())
+	cursor, err := store.reservationRequestCollection.Find(store.ctx, filter)
+	defer cursor.Close(store.ctx)
 
 	if err != nil {
 		return nil, err
@@ -82,16 +91,16 @@ func decodeReservationRequests(cursor *mongo.Cursor) (reservationRequests []*dom
 func (store *ReservationRequestMongoDBStore) GetByAccommodationId(accommodationId primitive.ObjectID) ([]*domain.ReservationRequest, error) {
 	filter := bson.M{"accommodation_id": accommodationId}
 	var reservationRequests []*domain.ReservationRequest
-	cursor, err := store.reservationRequestCollection.Find(context.TODO(), filter)
+	cursor, err := store.reservationRequestCollection.Find(store.ctx, filter)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	if err = cursor.All(context.TODO(), &reservationRequests); err != nil {
+	if err = cursor.All(store.ctx, &reservationRequests); err != nil {
 		log.Fatal(err)
 	}
 
-	if err := cursor.Close(context.TODO()); err != nil {
+	if err := cursor.Close(store.ctx); err != nil {
 		log.Fatal(err)
 	}
 	return reservationRequests, nil
@@ -103,16 +112,16 @@ func (store *ReservationRequestMongoDBStore) GetByAccommodationIdAndType(accommo
 		"status":           requestType,
 	}
 	var reservationRequests []*domain.ReservationRequest
-	cursor, err := store.reservationRequestCollection.Find(context.TODO(), filter)
+	cursor, err := store.reservationRequestCollection.Find(store.ctx, filter)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	if err = cursor.All(context.TODO(), &reservationRequests); err != nil {
+	if err = cursor.All(store.ctx, &reservationRequests); err != nil {
 		log.Fatal(err)
 	}
 
-	if err := cursor.Close(context.TODO()); err != nil {
+	if err := cursor.Close(store.ctx); err != nil {
 		log.Fatal(err)
 	}
 	return reservationRequests, nil
@@ -122,18 +131,23 @@ func (store *ReservationRequestMongoDBStore) Update(id primitive.ObjectID, reser
 	filter := bson.M{"_id": id}
 
 	updateFields := bson.D{
-		{"accommodation_id", reservationRequest.AccommodationId},
-		{"accommodation_name", reservationRequest.AccommodationName},
-		{"user_id", reservationRequest.UserId},
-		{"start", reservationRequest.Start},
-		{"end", reservationRequest.End},
-		{"number_of_guests", reservationRequest.NumberOfGuests},
-		{"price_total", reservationRequest.PriceTotal},
-		{"status", reservationRequest.Status},
-	}
-	update := bson.D{{"$set", updateFields}}
-
-	_, err := store.reservationRequestCollection.UpdateOne(context.TODO(), filter, update)
+		{Key: "accommodation_id", Value: reservationRequest.AccommodationId},
+		{Key: "accommodation_name", Value: reservationRequest.AccommodationName},
+		{Key: "user_id", Value: reservationRequest.UserId},
+		{Key: "start", Value: reservationRequest.Start},
+		{Key: "end", Value: reservationRequest.End},
+		{Key: "number_of_guests", Value: reservationRequest.NumberOfGuests},
+		{Key: "price_total", Value: reservationRequest.PriceTotal},
+		{Key: "status", Value: reservationRequest.Status},
+		{Key: "expires_at", Value: reservationRequest.ExpiresAt},
+		{Key: "remind_at", Value: reservationRequest.RemindAt},
+		{Key: "host_reminded", Value: reservationRequest.HostReminded},
+		{Key: "refund_amount", Value: reservationRequest.RefundAmount},
+		{Key: "canceled_at", Value: reservationRequest.CanceledAt},
+	}
+	update := bson.D{{Key: "$set", Value: updateFields}}
+
+	_, err := store.reservationRequestCollection.UpdateOne(store.ctx, filter, update)
 	if err != nil {
 		return err
 	}
@@ -142,7 +156,7 @@ func (store *ReservationRequestMongoDBStore) Update(id primitive.ObjectID, reser
 
 func (store *ReservationRequestMongoDBStore) Delete(id primitive.ObjectID) error {
 	filter := bson.M{"_id": id}
-	_, err := store.reservationRequestCollection.DeleteOne(context.TODO(), filter)
+	_, err := store.reservationRequestCollection.DeleteOne(store.ctx, filter)
 	if err != nil {
 		return err
 	}
@@ -151,19 +165,27 @@ func (store *ReservationRequestMongoDBStore) Delete(id primitive.ObjectID) error
 
 func (store *ReservationRequestMongoDBStore) DeleteByHost(id string) error {
 	filter := bson.M{"host_id": id}
-	_, err := store.reservationRequestCollection.DeleteMany(context.TODO(), filter)
+	_, err := store.reservationRequestCollection.DeleteMany(store.ctx, filter)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (store *ReservationRequestMongoDBStore) CancelOverlappingPendingRequests(reservationRequest *domain.ReservationRequest) error {
+// CancelOverlappingPendingRequests declines the other pending requests whose stay overlaps start to end, and returns
+// only those it declined itself.
+func (store *ReservationRequestMongoDBStore) CancelOverlappingPendingRequests(reservationRequest *domain.ReservationRequest, start time.Time, end time.Time) ([]primitive.ObjectID, error) {
 	filter := bson.M{
+		"_id":              bson.M{"$ne": reservationRequest.Id},
 		"accommodation_id": reservationRequest.AccommodationId,
-		"status":           0,
-		"start":            bson.M{"$lt": reservationRequest.End},
-		"end":              bson.M{"$gt": reservationRequest.Start},
+		"status":           domain.Pending,
+		"start":            bson.M{"$lt": end},
+		"end":              bson.M{"$gt": start},
+	}
+
+	overlappingRequests, err := store.filter(filter)
+	if err != nil {
+		return nil, err
 	}
 
 	update := bson.M{
@@ -171,19 +193,37 @@ func (store *ReservationRequestMongoDBStore) CancelOverlappingPendingRequests(re
 			"status": domain.DeclinedByHost,
 		},
 	}
-
-	updateResult, err := store.reservationRequestCollection.UpdateMany(context.TODO(), filter, update)
-	if err != nil {
-		return err
+	var ids []primitive.ObjectID
+	for _, overlappingRequest := range overlappingRequests {
+		updateResult, err := store.reservationRequestCollection.UpdateOne(store.ctx, bson.M{"_id": overlappingRequest.Id, "status": domain.Pending}, update)
+		if err != nil {
+			return ids, err
+		}
+		if updateResult.ModifiedCount > 0 {
+			ids = append(ids, overlappingRequest.Id)
+		}
 	}
+	log.Printf("Declined %d of %d overlapping pending requests.\n", len(ids), len(overlappingRequests))
 
-	if updateResult.MatchedCount > 0 {
-		log.Printf("Matched %v documents and updated %v documents.\n", updateResult.MatchedCount, updateResult.ModifiedCount)
-	} else {
-		log.Printf("No documents matched the query.")
+	return ids, nil
+}
+
+func (store *ReservationRequestMongoDBStore) RestorePendingRequests(ids []primitive.ObjectID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	filter := bson.M{
+		"_id":    bson.M{"$in": ids},
+		"status": domain.DeclinedByHost,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status": domain.Pending,
+		},
 	}
 
-	return nil
+	_, err := store.reservationRequestCollection.UpdateMany(store.ctx, filter, update)
+	return err
 }
 
 func (store *ReservationRequestMongoDBStore) GetByClientId(clientId string) ([]*domain.ReservationRequest, error) {
@@ -212,7 +252,7 @@ func (store *ReservationRequestMongoDBStore) GetByHostAndTimeAndSearch(hostId st
 
 	store.searchByString(search, filter)
 
-	cursor, err := store.reservationRequestCollection.Find(context.TODO(), filter)
+	cursor, err := store.reservationRequestCollection.Find(store.ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -241,7 +281,7 @@ func (store *ReservationRequestMongoDBStore) GetByClientIdAndTimeAndSearch(guest
 
 	store.searchByString(search, filter)
 
-	cursor, err := store.reservationRequestCollection.Find(context.TODO(), filter)
+	cursor, err := store.reservationRequestCollection.Find(store.ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -254,41 +294,35 @@ func (store *ReservationRequestMongoDBStore) GetByClientIdAndHostId(clientId str
 		"host_id": hostId,
 	}
 
-	cursor, err := store.reservationRequestCollection.Find(context.TODO(), filter)
+	cursor, err := store.reservationRequestCollection.Find(store.ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 	return decodeReservationRequests(cursor)
 }
 
-func (store *ReservationRequestMongoDBStore) GetPastAcceptedReservationRequestByClientIdAndHostId(clientId string, hostId string) ([]*domain.ReservationRequest, error) {
+func (store *ReservationRequestMongoDBStore) GetCompletedByClientIdAndHostId(clientId string, hostId string) ([]*domain.ReservationRequest, error) {
 	filter := bson.M{
 		"user_id": clientId,
 		"host_id": hostId,
-		"status":  1,
-		"end": bson.M{
-			"$lt": time.Now(),
-		},
+		"status":  domain.Completed,
 	}
 
-	cursor, err := store.reservationRequestCollection.Find(context.TODO(), filter)
+	cursor, err := store.reservationRequestCollection.Find(store.ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 	return decodeReservationRequests(cursor)
 }
 
-func (store *ReservationRequestMongoDBStore) GetByClientIdAndAccommodationId(reviewerId string, accommodationId primitive.ObjectID) ([]*domain.ReservationRequest, error) {
+func (store *ReservationRequestMongoDBStore) GetCompletedByClientIdAndAccommodationId(reviewerId string, accommodationId primitive.ObjectID) ([]*domain.ReservationRequest, error) {
 	filter := bson.M{
 		"user_id":          reviewerId,
 		"accommodation_id": accommodationId,
-		"status":           1,
-		"end": bson.M{
-			"$lt": time.Now(),
-		},
+		"status":           domain.Completed,
 	}
 
-	cursor, err := store.reservationRequestCollection.Find(context.TODO(), filter)
+	cursor, err := store.reservationRequestCollection.Find(store.ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -306,9 +340,143 @@ func (store *ReservationRequestMongoDBStore) DeleteByAccommodation(accommodation
 		},
 	}
 
-	_, err := store.reservationRequestCollection.UpdateMany(context.TODO(), filter, update)
+	_, err := store.reservationRequestCollection.UpdateMany(store.ctx, filter, update)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+func (store *ReservationRequestMongoDBStore) GetApprovedEndedBefore(end time.Time) ([]*domain.ReservationRequest, error) {
+	filter := bson.M{
+		"status": domain.Approved,
+		"end":    bson.M{"$lte": end},
+	}
+	return store.filter(filter)
+}
+
+func (store *ReservationRequestMongoDBStore) UpdateStatus(id primitive.ObjectID, current domain.ReservationRequestStatus, next domain.ReservationRequestStatus) (bool, error) {
+	filter := bson.M{
+		"_id":    id,
+		"status": current,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status": next,
+		},
+	}
+
+	updateResult, err := store.reservationRequestCollection.UpdateOne(store.ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return updateResult.ModifiedCount > 0, nil
+}
+
+func (store *ReservationRequestMongoDBStore) GetPendingExpiredBefore(now time.Time) ([]*domain.ReservationRequest, error) {
+	filter := bson.M{
+		"status":     domain.Pending,
+		"expires_at": bson.M{"$lte": now},
+	}
+	return store.filter(filter)
+}
+
+func (store *ReservationRequestMongoDBStore) GetPendingToRemindBefore(now time.Time) ([]*domain.ReservationRequest, error) {
+	filter := bson.M{
+		"status":        domain.Pending,
+		"host_reminded": false,
+		"remind_at":     bson.M{"$lte": now},
+	}
+	return store.filter(filter)
+}
+
+func (store *ReservationRequestMongoDBStore) MarkHostReminded(id primitive.ObjectID) (bool, error) {
+	filter := bson.M{
+		"_id":           id,
+		"status":        domain.Pending,
+		"host_reminded": false,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"host_reminded": true,
+		},
+	}
+
+	updateResult, err := store.reservationRequestCollection.UpdateOne(store.ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return updateResult.ModifiedCount > 0, nil
+}
+
+func (store *ReservationRequestMongoDBStore) CancelReservation(id primitive.ObjectID, refundAmount domain.Money, canceledAt time.Time) (bool, error) {
+	filter := bson.M{
+		"_id":    id,
+		"status": domain.Approved,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":        domain.DeclinedByUser,
+			"refund_amount": refundAmount,
+			"canceled_at":   canceledAt,
+		},
+	}
+
+	updateResult, err := store.reservationRequestCollection.UpdateOne(store.ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return updateResult.ModifiedCount > 0, nil
+}
+
+// RestoreCanceledReservation undoes CancelReservation, putting back the status, refund and cancellation time it replaced.
+func (store *ReservationRequestMongoDBStore) RestoreCanceledReservation(id primitive.ObjectID, previous *domain.ReservationRequest) error {
+	filter := bson.M{
+		"_id":    id,
+		"status": domain.DeclinedByUser,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":        previous.Status,
+			"refund_amount": previous.RefundAmount,
+		},
+	}
+	if previous.CanceledAt.IsZero() {
+		update["$unset"] = bson.M{"canceled_at": ""}
+	} else {
+		update["$set"].(bson.M)["canceled_at"] = previous.CanceledAt
+	}
+
+	_, err := store.reservationRequestCollection.UpdateOne(store.ctx, filter, update)
+	return err
+}
+
+func (store *ReservationRequestMongoDBStore) UpdateDates(id primitive.ObjectID, start time.Time, end time.Time) error {
+	filter := bson.M{"_id": id}
+	update := bson.M{
+		"$set": bson.M{
+			"start": start,
+			"end":   end,
+		},
+	}
+
+	_, err := store.reservationRequestCollection.UpdateOne(store.ctx, filter, update)
+	return err
+}
+
+// AnonymizeClient replaces user_id, the only personal field of a request. The other fields are retained as they describe
+// the host's booking rather than the guest: accommodation_id, accommodation_name, host_id, start, end,
+// number_of_guests, price_total, refund_amount, status and the request's own timestamps.
+func (store *ReservationRequestMongoDBStore) AnonymizeClient(clientId string, tombstoneId string, anonymizedAt time.Time) (int64, error) {
+	update := bson.M{
+		"$set": bson.M{
+			"user_id":       tombstoneId,
+			"anonymized_at": anonymizedAt,
+		},
+	}
+	result, err := store.reservationRequestCollection.UpdateMany(store.ctx, bson.M{"user_id": clientId}, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
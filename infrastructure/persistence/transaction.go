@@ -0,0 +1,94 @@
+package persistence
+
+import (
+	"context"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"log"
+)
+
+type MongoTransactionManager struct {
+	client               *mongo.Client
+	supportsTransactions bool
+}
+
+func NewMongoTransactionManager(client *mongo.Client) domain.TransactionManager {
+	return &MongoTransactionManager{
+		client:               client,
+		supportsTransactions: supportsTransactions(client),
+	}
+}
+
+// Multi-document transactions need a replica set or a sharded cluster. Standalone
+// deployments fall back to running the registered compensations on failure.
+func supportsTransactions(client *mongo.Client) bool {
+	var result bson.M
+	err := client.Database("admin").RunCommand(context.TODO(), bson.D{{Key: "isMaster", Value: 1}}).Decode(&result)
+	if err != nil {
+		log.Printf("failed to detect mongo topology, falling back to compensation: %v", err)
+		return false
+	}
+	if _, ok := result["setName"]; ok {
+		return true
+	}
+	return result["msg"] == "isdbgrid"
+}
+
+func (manager *MongoTransactionManager) WithTransaction(fn func(transaction domain.Transaction) error) error {
+	if !manager.supportsTransactions {
+		return withCompensation(fn)
+	}
+
+	session, err := manager.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(context.TODO())
+
+	_, err = session.WithTransaction(context.TODO(), func(sessionContext mongo.SessionContext) (interface{}, error) {
+		return nil, fn(&mongoTransaction{ctx: sessionContext})
+	})
+	return err
+}
+
+func withCompensation(fn func(transaction domain.Transaction) error) error {
+	transaction := &compensatingTransaction{ctx: context.TODO()}
+	err := fn(transaction)
+	if err != nil {
+		transaction.rollback()
+	}
+	return err
+}
+
+type mongoTransaction struct {
+	ctx context.Context
+}
+
+func (transaction *mongoTransaction) Context() context.Context {
+	return transaction.ctx
+}
+
+func (transaction *mongoTransaction) Compensate(undo func() error) {
+}
+
+type compensatingTransaction struct {
+	ctx           context.Context
+	compensations []func() error
+}
+
+func (transaction *compensatingTransaction) Context() context.Context {
+	return transaction.ctx
+}
+
+func (transaction *compensatingTransaction) Compensate(undo func() error) {
+	transaction.compensations = append(transaction.compensations, undo)
+}
+
+func (transaction *compensatingTransaction) rollback() {
+	for i := len(transaction.compensations) - 1; i >= 0; i-- {
+		if err := transaction.compensations[i](); err != nil {
+			log.Printf("failed to compensate transaction step: %v", err)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
 )
 
 const (
@@ -17,12 +18,52 @@ const (
 
 type UnavailabilityMongoDBStore struct {
 	unavailability *mongo.Collection
+	ctx            context.Context
 }
 
 func NewUnavailabilityMongoDBStore(client *mongo.Client) domain.UnavailabilityStore {
 	unavailability := client.Database(DATABASE).Collection(COLLECTION)
 	return &UnavailabilityMongoDBStore{
 		unavailability: unavailability,
+		ctx:            context.TODO(),
+	}
+}
+
+func EnsureIndexes(client *mongo.Client) error {
+	return ensureIndexes(client.Database(DATABASE).Collection(COLLECTION))
+}
+
+func ensureIndexes(unavailability *mongo.Collection) error {
+	_, err := unavailability.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "accommodation_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "accommodation_id", Value: 1},
+				{Key: "unavailability_periods.start", Value: 1},
+				{Key: "unavailability_periods.end", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "accommodation_id", Value: 1},
+				{Key: "unavailability_periods.blocked_start", Value: 1},
+				{Key: "unavailability_periods.blocked_end", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{{Key: "host_id", Value: 1}},
+		},
+	})
+	return err
+}
+
+func (store *UnavailabilityMongoDBStore) WithContext(ctx context.Context) domain.UnavailabilityStore {
+	return &UnavailabilityMongoDBStore{
+		unavailability: store.unavailability,
+		ctx:            ctx,
 	}
 }
 
@@ -33,7 +74,7 @@ func (store *UnavailabilityMongoDBStore) Get(id primitive.ObjectID) (*domain.Una
 
 func (store *UnavailabilityMongoDBStore) Insert(unavailability *domain.Unavailability) error {
 	unavailability.Id = primitive.NewObjectID()
-	result, err := store.unavailability.InsertOne(context.TODO(), unavailability)
+	result, err := store.unavailability.InsertOne(store.ctx, unavailability)
 	if err != nil {
 		return err
 	}
@@ -42,7 +83,7 @@ func (store *UnavailabilityMongoDBStore) Insert(unavailability *domain.Unavailab
 }
 
 func (store *UnavailabilityMongoDBStore) DeleteAll() {
-	store.unavailability.DeleteMany(context.TODO(), bson.D{{}})
+	store.unavailability.DeleteMany(store.ctx, bson.D{{}})
 }
 
 func (store *UnavailabilityMongoDBStore) GetAll() ([]*domain.Unavailability, error) {
@@ -60,7 +101,7 @@ func (store *UnavailabilityMongoDBStore) GetPeriod(periodId primitive.ObjectID)
 		},
 	}
 	var result domain.Unavailability
-	err := store.unavailability.FindOne(context.TODO(), filter, options.FindOne().SetProjection(projection)).Decode(&result)
+	err := store.unavailability.FindOne(store.ctx, filter, options.FindOne().SetProjection(projection)).Decode(&result)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return period, errors.New("period not found")
@@ -79,36 +120,35 @@ func (store *UnavailabilityMongoDBStore) GetPeriod(periodId primitive.ObjectID)
 func (store *UnavailabilityMongoDBStore) GetUnavailabilityPeriods(id primitive.ObjectID) ([]domain.UnavailabilityPeriod, error) {
 	var unavailability domain.Unavailability
 	filter := bson.M{"_id": id}
-	err := store.unavailability.FindOne(context.TODO(), filter).Decode(&unavailability)
+	err := store.unavailability.FindOne(store.ctx, filter).Decode(&unavailability)
 	if err != nil {
 		return nil, err
 	}
 	return unavailability.UnavailabilityPeriods, nil
 }
 
-func (store *UnavailabilityMongoDBStore) UpdateUnavailabilityPeriods(unavailabilityId primitive.ObjectID, periods []domain.UnavailabilityPeriod) error {
-	filter := bson.M{"_id": unavailabilityId}
-
-	_, err := store.GetUnavailabilityPeriods(unavailabilityId)
-	if err != nil {
-		return err
+func (store *UnavailabilityMongoDBStore) UpdateUnavailabilityPeriods(unavailabilityId primitive.ObjectID, version int64, periods []domain.UnavailabilityPeriod) error {
+	filter := bson.M{
+		"_id":     unavailabilityId,
+		"version": versionFilter(version),
+	}
+	update := bson.M{
+		"$set": bson.M{"unavailability_periods": periods},
+		"$inc": bson.M{"version": 1},
 	}
 
-	update := bson.M{"$set": bson.M{"unavailability_periods": periods}}
-
-	_, err = store.unavailability.UpdateOne(context.TODO(), filter, update)
-	return err
+	return store.compareAndSwap(filter, update)
 }
 
 func (store *UnavailabilityMongoDBStore) filterOne(filter interface{}) (unavailability *domain.Unavailability, err error) {
-	result := store.unavailability.FindOne(context.TODO(), filter)
+	result := store.unavailability.FindOne(store.ctx, filter)
 	err = result.Decode(&unavailability)
 	return
 }
 
 func (store *UnavailabilityMongoDBStore) filter(filter interface{}) ([]*domain.Unavailability, error) {
-	cursor, err := store.unavailability.Find(context.TODO(), filter)
-	defer cursor.Close(context.TODO())
+	cursor, err := store.unavailability.Find(store.ctx, filter)
+	defer cursor.Close(store.ctx)
 
 	if err != nil {
 		return nil, err
@@ -119,7 +159,7 @@ func (store *UnavailabilityMongoDBStore) filter(filter interface{}) ([]*domain.U
 func (store *UnavailabilityMongoDBStore) GetByAccommodationId(accommodationId primitive.ObjectID) (*domain.Unavailability, error) {
 	var unavailability domain.Unavailability
 	filter := bson.M{"accommodation_id": accommodationId}
-	err := store.unavailability.FindOne(context.TODO(), filter).Decode(&unavailability)
+	err := store.unavailability.FindOne(store.ctx, filter).Decode(&unavailability)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, nil
@@ -131,33 +171,148 @@ func (store *UnavailabilityMongoDBStore) GetByAccommodationId(accommodationId pr
 
 func (store *UnavailabilityMongoDBStore) DeleteByAccommodationId(accommodationId primitive.ObjectID) error {
 	filter := bson.M{"accommodation_id": accommodationId}
-	_, err := store.unavailability.DeleteOne(context.TODO(), filter)
+	_, err := store.unavailability.DeleteOne(store.ctx, filter)
 
 	return err
 }
 
+func (store *UnavailabilityMongoDBStore) GetByAccommodationIds(accommodationIds []primitive.ObjectID) ([]*domain.Unavailability, error) {
+	filter := bson.M{"accommodation_id": bson.M{"$in": accommodationIds}}
+	return store.filter(filter)
+}
+
+// GetUnavailableAccommodationIds resolves the whole id list in one query, returning the ids with a period, or the turnover
+// buffer stored around a reservation, overlapping [start, end), and those with recurring blocks or stay rules, which are
+// not stored as periods. It is a prefilter: callers check the stays themselves on the documents of the returned ids.
+func (store *UnavailabilityMongoDBStore) GetUnavailableAccommodationIds(accommodationIds []primitive.ObjectID, start time.Time, end time.Time) ([]primitive.ObjectID, error) {
+	filter := bson.M{
+		"accommodation_id": bson.M{"$in": accommodationIds},
+		"$or": bson.A{
+			bson.M{"unavailability_periods": bson.M{"$elemMatch": bson.M{"start": bson.M{"$lt": end}, "end": bson.M{"$gt": start}}}},
+			bson.M{"unavailability_periods": bson.M{"$elemMatch": bson.M{"blocked_start": bson.M{"$lt": end}, "blocked_end": bson.M{"$gt": start}}}},
+			bson.M{"recurring_blocks.0": bson.M{"$exists": true}},
+			bson.M{"stay_rules.min_nights": bson.M{"$gt": 0}},
+			bson.M{"stay_rules.max_nights": bson.M{"$gt": 0}},
+			bson.M{"stay_rules.check_in_days.0": bson.M{"$exists": true}},
+			bson.M{"stay_rules.overrides.0": bson.M{"$exists": true}},
+		},
+	}
+	projection := bson.M{"_id": 0, "accommodation_id": 1}
+
+	cursor, err := store.unavailability.Find(store.ctx, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(store.ctx)
+
+	var unavailableIds []primitive.ObjectID
+	for cursor.Next(store.ctx) {
+		var result struct {
+			AccommodationId primitive.ObjectID `bson:"accommodation_id"`
+		}
+		if err := cursor.Decode(&result); err != nil {
+			return nil, err
+		}
+		unavailableIds = append(unavailableIds, result.AccommodationId)
+	}
+	return unavailableIds, cursor.Err()
+}
+
 func (store *UnavailabilityMongoDBStore) GetByHostId(hostId string) ([]*domain.Unavailability, error) {
 	filter := bson.M{"host_id": hostId}
 	return store.filter(filter)
 }
 
+func (store *UnavailabilityMongoDBStore) GetWithExternalCalendars() ([]*domain.Unavailability, error) {
+	filter := bson.M{"external_calendars.0": bson.M{"$exists": true}}
+	return store.filter(filter)
+}
+
 func (store *UnavailabilityMongoDBStore) Update(id primitive.ObjectID, unavailability *domain.Unavailability) error {
-	filter := bson.M{"_id": id}
+	filter := bson.M{
+		"_id":     id,
+		"version": versionFilter(unavailability.Version),
+	}
+
+	updateFields := bson.M{
+		"accommodation_id":       unavailability.AccommodationId,
+		"accommodation_name":     unavailability.AccommodationName,
+		"host_id":                unavailability.HostId,
+		"unavailability_periods": unavailability.UnavailabilityPeriods,
+		"review_reservation_request_automatically": unavailability.ReviewReservationRequestAutomatically,
+		"reservation_request_response_window":      unavailability.ReservationRequestResponseWindow,
+		"cancellation_policy":                      unavailability.CancellationPolicy,
+		"pricing":                                  unavailability.Pricing,
+		"stay_rules":                               unavailability.StayRules,
+		"turnover_buffer":                          unavailability.TurnoverBuffer,
+		"time_zone":                                unavailability.TimeZone,
+		"check_in_time":                            unavailability.CheckInTime,
+		"check_out_time":                           unavailability.CheckOutTime,
+		"recurring_blocks":                         unavailability.RecurringBlocks,
+		"ical_export_token":                        unavailability.ICalExportToken,
+		"external_calendars":                       unavailability.ExternalCalendars,
+		"source_updated_at":                        unavailability.SourceUpdatedAt,
+	}
+	update := bson.M{
+		"$set": updateFields,
+		"$inc": bson.M{"version": 1},
+	}
 
-	updateFields := bson.D{
-		{"accommodation_id", unavailability.AccommodationId},
-		{"unavailability_periods", unavailability.UnavailabilityPeriods},
-		{"review_reservation_request_automatically", unavailability.ReviewReservationRequestAutomatically},
+	return store.compareAndSwap(filter, update)
+}
+
+// UpsertAccommodation writes the accommodation service's details of an accommodation, creating its unavailability if
+// there is none. Details from SourceUpdatedAt or later are kept: the filter then misses the document and the insert it
+// falls back to fails on the unique accommodation id.
+func (store *UnavailabilityMongoDBStore) UpsertAccommodation(unavailability *domain.Unavailability) error {
+	filter := bson.M{
+		"accommodation_id": unavailability.AccommodationId,
+		"$or": bson.A{
+			bson.M{"source_updated_at": bson.M{"$exists": false}},
+			bson.M{"source_updated_at": bson.M{"$lt": unavailability.SourceUpdatedAt}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"accommodation_name": unavailability.AccommodationName,
+			"host_id":            unavailability.HostId,
+			"review_reservation_request_automatically": unavailability.ReviewReservationRequestAutomatically,
+			"reservation_request_response_window":      unavailability.ReservationRequestResponseWindow,
+			"source_updated_at":                        unavailability.SourceUpdatedAt,
+		},
+		"$setOnInsert": bson.M{
+			"_id":                    primitive.NewObjectID(),
+			"unavailability_periods": bson.A{},
+		},
+		"$inc": bson.M{"version": 1},
+	}
+
+	_, err := store.unavailability.UpdateOne(store.ctx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return domain.ErrConcurrentModification
 	}
-	update := bson.D{{"$set", updateFields}}
+	return err
+}
 
-	_, err := store.unavailability.UpdateOne(context.TODO(), filter, update)
+func (store *UnavailabilityMongoDBStore) compareAndSwap(filter bson.M, update bson.M) error {
+	updateResult, err := store.unavailability.UpdateOne(store.ctx, filter, update)
 	if err != nil {
 		return err
 	}
+	if updateResult.MatchedCount == 0 {
+		return domain.ErrConcurrentModification
+	}
 	return nil
 }
 
+// Documents written before versioning have no version field and count as version 0.
+func versionFilter(version int64) interface{} {
+	if version == 0 {
+		return bson.M{"$in": bson.A{0, nil}}
+	}
+	return version
+}
+
 func decode(cursor *mongo.Cursor) (unavailabilities []*domain.Unavailability, err error) {
 	for cursor.Next(context.TODO()) {
 		var unavailability domain.Unavailability
@@ -0,0 +1,100 @@
+package unavailability
+
+import (
+	"context"
+	"github.com/ZMS-DevOps/booking-service/domain"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/persistence"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"os"
+	"testing"
+	"time"
+)
+
+const (
+	benchmarkDatabase       = "bookingdb_benchmark"
+	benchmarkAccommodations = 2000
+	benchmarkPeriods        = 40
+	benchmarkSearchIds      = 200
+)
+
+// BenchmarkFilterAvailable compares loading every searched accommodation one query at a time, as FilterAvailable did
+// originally, and in a single query, with querying the period bounds of the stay's prefilter window first and loading
+// only the accommodations that may be unavailable.
+// It needs the MongoDB that DB_HOST and DB_PORT point to.
+func BenchmarkFilterAvailable(b *testing.B) {
+	host := os.Getenv("DB_HOST")
+	if host == "" {
+		b.Skip("DB_HOST is not set")
+	}
+	client, err := persistence.GetClient(os.Getenv("MONGO_INITDB_ROOT_USERNAME"), os.Getenv("MONGO_INITDB_ROOT_PASSWORD"), host, os.Getenv("DB_PORT"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	database := client.Database(benchmarkDatabase)
+	defer database.Drop(context.TODO())
+
+	collection := database.Collection(COLLECTION)
+	if err := ensureIndexes(collection); err != nil {
+		b.Fatal(err)
+	}
+	store := &UnavailabilityMongoDBStore{unavailability: collection, ctx: context.TODO()}
+
+	first := time.Date(2026, time.January, 1, 15, 0, 0, 0, time.UTC)
+	var ids []primitive.ObjectID
+	for i := 0; i < benchmarkAccommodations; i++ {
+		unavailability := &domain.Unavailability{
+			Id:              primitive.NewObjectID(),
+			AccommodationId: primitive.NewObjectID(),
+		}
+		// Every fourth accommodation is booked all year; the others only in January and February.
+		for j := 0; j < benchmarkPeriods; j++ {
+			start := first.AddDate(0, 0, j+j/2)
+			if i%4 == 0 {
+				start = first.AddDate(0, 0, 9*j+i%9)
+			}
+			unavailability.UnavailabilityPeriods = append(unavailability.UnavailabilityPeriods, domain.UnavailabilityPeriod{
+				Id:     primitive.NewObjectID(),
+				Start:  start,
+				End:    start.AddDate(0, 0, 1).Add(-4 * time.Hour),
+				Reason: domain.Reserved,
+			})
+		}
+		if err := store.Insert(unavailability); err != nil {
+			b.Fatal(err)
+		}
+		ids = append(ids, unavailability.AccommodationId)
+	}
+	ids = ids[:benchmarkSearchIds]
+	checkIn := domain.DateOf(first.AddDate(0, 4, 0), time.UTC)
+	windowStart, windowEnd := domain.Stay{CheckIn: checkIn, CheckOut: checkIn.AddDays(2)}.PrefilterWindow()
+
+	b.Run("GetByAccommodationId", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, id := range ids {
+				if _, err := store.GetByAccommodationId(id); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("GetByAccommodationIds", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := store.GetByAccommodationIds(ids); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("GetUnavailableAccommodationIds", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			candidateIds, err := store.GetUnavailableAccommodationIds(ids, windowStart, windowEnd)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if len(candidateIds) > 0 {
+				if _, err := store.GetByAccommodationIds(candidateIds); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
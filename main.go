@@ -6,7 +6,6 @@ import (
 	"github.com/ZMS-DevOps/booking-service/startup"
 	cfg "github.com/ZMS-DevOps/booking-service/startup/config"
 	"github.com/afiskon/promtail-client/promtail"
-	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/jaeger"
 	"go.opentelemetry.io/otel/propagation"
@@ -64,15 +63,7 @@ func main() {
 
 	loki, err := initPromtailClient(config.LokiHost)
 
-	producer, _ := kafka.NewProducer(&kafka.ConfigMap{
-		"bootstrap.servers": config.BootstrapServers,
-		"security.protocol": "sasl_plaintext",
-		"sasl.mechanism":    "PLAIN",
-		"sasl.username":     "user1",
-		"sasl.password":     config.KafkaAuthPassword,
-	})
-	defer producer.Close()
 	server := startup.NewServer(config, tp, loki)
-	server.Start(producer)
+	server.Start()
 	loki.Shutdown()
 }
@@ -25,11 +25,14 @@ type BookingServiceClient interface {
 	AddUnavailability(ctx context.Context, in *AddUnavailabilityRequest, opts ...grpc.CallOption) (*AddUnavailabilityResponse, error)
 	EditAccommodation(ctx context.Context, in *EditAccommodationRequest, opts ...grpc.CallOption) (*EditAccommodationResponse, error)
 	FilterAvailableAccommodation(ctx context.Context, in *FilterAvailableAccommodationRequest, opts ...grpc.CallOption) (*FilterAvailableAccommodationResponse, error)
+	FindFlexibleAvailability(ctx context.Context, in *FindFlexibleAvailabilityRequest, opts ...grpc.CallOption) (*FindFlexibleAvailabilityResponse, error)
 	CheckDeleteHost(ctx context.Context, in *CheckDeleteHostRequest, opts ...grpc.CallOption) (*CheckDeleteHostResponse, error)
 	CheckDeleteClient(ctx context.Context, in *CheckDeleteClientRequest, opts ...grpc.CallOption) (*CheckDeleteClientResponse, error)
 	CheckGuestHasReservationForHost(ctx context.Context, in *CheckGuestHasReservationForHostRequest, opts ...grpc.CallOption) (*CheckGuestHasReservationForHostResponse, error)
 	CheckGuestHasReservationForAccommodation(ctx context.Context, in *CheckGuestHasReservationForAccommodationRequest, opts ...grpc.CallOption) (*CheckGuestHasReservationForAccommodationResponse, error)
 	CheckAccommodationHasReservation(ctx context.Context, in *CheckAccommodationHasReservationRequest, opts ...grpc.CallOption) (*CheckAccommodationHasReservationResponse, error)
+	GetPriceQuote(ctx context.Context, in *GetPriceQuoteRequest, opts ...grpc.CallOption) (*GetPriceQuoteResponse, error)
+	GetCalendar(ctx context.Context, in *GetCalendarRequest, opts ...grpc.CallOption) (*GetCalendarResponse, error)
 }
 
 type bookingServiceClient struct {
@@ -67,6 +70,15 @@ func (c *bookingServiceClient) FilterAvailableAccommodation(ctx context.Context,
 	return out, nil
 }
 
+func (c *bookingServiceClient) FindFlexibleAvailability(ctx context.Context, in *FindFlexibleAvailabilityRequest, opts ...grpc.CallOption) (*FindFlexibleAvailabilityResponse, error) {
+	out := new(FindFlexibleAvailabilityResponse)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/FindFlexibleAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) CheckDeleteHost(ctx context.Context, in *CheckDeleteHostRequest, opts ...grpc.CallOption) (*CheckDeleteHostResponse, error) {
 	out := new(CheckDeleteHostResponse)
 	err := c.cc.Invoke(ctx, "/booking.BookingService/CheckDeleteHost", in, out, opts...)
@@ -112,6 +124,24 @@ func (c *bookingServiceClient) CheckAccommodationHasReservation(ctx context.Cont
 	return out, nil
 }
 
+func (c *bookingServiceClient) GetPriceQuote(ctx context.Context, in *GetPriceQuoteRequest, opts ...grpc.CallOption) (*GetPriceQuoteResponse, error) {
+	out := new(GetPriceQuoteResponse)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/GetPriceQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetCalendar(ctx context.Context, in *GetCalendarRequest, opts ...grpc.CallOption) (*GetCalendarResponse, error) {
+	out := new(GetCalendarResponse)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/GetCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookingServiceServer is the server API for BookingService service.
 // All implementations must embed UnimplementedBookingServiceServer
 // for forward compatibility
@@ -119,11 +149,14 @@ type BookingServiceServer interface {
 	AddUnavailability(context.Context, *AddUnavailabilityRequest) (*AddUnavailabilityResponse, error)
 	EditAccommodation(context.Context, *EditAccommodationRequest) (*EditAccommodationResponse, error)
 	FilterAvailableAccommodation(context.Context, *FilterAvailableAccommodationRequest) (*FilterAvailableAccommodationResponse, error)
+	FindFlexibleAvailability(context.Context, *FindFlexibleAvailabilityRequest) (*FindFlexibleAvailabilityResponse, error)
 	CheckDeleteHost(context.Context, *CheckDeleteHostRequest) (*CheckDeleteHostResponse, error)
 	CheckDeleteClient(context.Context, *CheckDeleteClientRequest) (*CheckDeleteClientResponse, error)
 	CheckGuestHasReservationForHost(context.Context, *CheckGuestHasReservationForHostRequest) (*CheckGuestHasReservationForHostResponse, error)
 	CheckGuestHasReservationForAccommodation(context.Context, *CheckGuestHasReservationForAccommodationRequest) (*CheckGuestHasReservationForAccommodationResponse, error)
 	CheckAccommodationHasReservation(context.Context, *CheckAccommodationHasReservationRequest) (*CheckAccommodationHasReservationResponse, error)
+	GetPriceQuote(context.Context, *GetPriceQuoteRequest) (*GetPriceQuoteResponse, error)
+	GetCalendar(context.Context, *GetCalendarRequest) (*GetCalendarResponse, error)
 	mustEmbedUnimplementedBookingServiceServer()
 }
 
@@ -140,6 +173,9 @@ func (UnimplementedBookingServiceServer) EditAccommodation(context.Context, *Edi
 func (UnimplementedBookingServiceServer) FilterAvailableAccommodation(context.Context, *FilterAvailableAccommodationRequest) (*FilterAvailableAccommodationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FilterAvailableAccommodation not implemented")
 }
+func (UnimplementedBookingServiceServer) FindFlexibleAvailability(context.Context, *FindFlexibleAvailabilityRequest) (*FindFlexibleAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindFlexibleAvailability not implemented")
+}
 func (UnimplementedBookingServiceServer) CheckDeleteHost(context.Context, *CheckDeleteHostRequest) (*CheckDeleteHostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckDeleteHost not implemented")
 }
@@ -155,6 +191,12 @@ func (UnimplementedBookingServiceServer) CheckGuestHasReservationForAccommodatio
 func (UnimplementedBookingServiceServer) CheckAccommodationHasReservation(context.Context, *CheckAccommodationHasReservationRequest) (*CheckAccommodationHasReservationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckAccommodationHasReservation not implemented")
 }
+func (UnimplementedBookingServiceServer) GetPriceQuote(context.Context, *GetPriceQuoteRequest) (*GetPriceQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceQuote not implemented")
+}
+func (UnimplementedBookingServiceServer) GetCalendar(context.Context, *GetCalendarRequest) (*GetCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendar not implemented")
+}
 func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
 
 // UnsafeBookingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -222,6 +264,24 @@ func _BookingService_FilterAvailableAccommodation_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_FindFlexibleAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindFlexibleAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).FindFlexibleAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/FindFlexibleAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).FindFlexibleAvailability(ctx, req.(*FindFlexibleAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookingService_CheckDeleteHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckDeleteHostRequest)
 	if err := dec(in); err != nil {
@@ -312,6 +372,42 @@ func _BookingService_CheckAccommodationHasReservation_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_GetPriceQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetPriceQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/GetPriceQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetPriceQuote(ctx, req.(*GetPriceQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/GetCalendar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetCalendar(ctx, req.(*GetCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -331,6 +427,10 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FilterAvailableAccommodation",
 			Handler:    _BookingService_FilterAvailableAccommodation_Handler,
 		},
+		{
+			MethodName: "FindFlexibleAvailability",
+			Handler:    _BookingService_FindFlexibleAvailability_Handler,
+		},
 		{
 			MethodName: "CheckDeleteHost",
 			Handler:    _BookingService_CheckDeleteHost_Handler,
@@ -351,6 +451,14 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckAccommodationHasReservation",
 			Handler:    _BookingService_CheckAccommodationHasReservation_Handler,
 		},
+		{
+			MethodName: "GetPriceQuote",
+			Handler:    _BookingService_GetPriceQuote_Handler,
+		},
+		{
+			MethodName: "GetCalendar",
+			Handler:    _BookingService_GetCalendar_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "booking_service.proto",
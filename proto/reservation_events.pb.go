@@ -0,0 +1,1720 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v5.26.1
+// source: reservation_events.proto
+
+package booking
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ActorRole int32
+
+const (
+	ActorRole_ACTOR_ROLE_UNSPECIFIED ActorRole = 0
+	ActorRole_ACTOR_ROLE_GUEST       ActorRole = 1
+	ActorRole_ACTOR_ROLE_HOST        ActorRole = 2
+	ActorRole_ACTOR_ROLE_SYSTEM      ActorRole = 3
+)
+
+// Enum value maps for ActorRole.
+var (
+	ActorRole_name = map[int32]string{
+		0: "ACTOR_ROLE_UNSPECIFIED",
+		1: "ACTOR_ROLE_GUEST",
+		2: "ACTOR_ROLE_HOST",
+		3: "ACTOR_ROLE_SYSTEM",
+	}
+	ActorRole_value = map[string]int32{
+		"ACTOR_ROLE_UNSPECIFIED": 0,
+		"ACTOR_ROLE_GUEST":       1,
+		"ACTOR_ROLE_HOST":        2,
+		"ACTOR_ROLE_SYSTEM":      3,
+	}
+)
+
+func (x ActorRole) Enum() *ActorRole {
+	p := new(ActorRole)
+	*p = x
+	return p
+}
+
+func (x ActorRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActorRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_reservation_events_proto_enumTypes[0].Descriptor()
+}
+
+func (ActorRole) Type() protoreflect.EnumType {
+	return &file_reservation_events_proto_enumTypes[0]
+}
+
+func (x ActorRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActorRole.Descriptor instead.
+func (ActorRole) EnumDescriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{0}
+}
+
+type ReservationStatus int32
+
+const (
+	ReservationStatus_RESERVATION_STATUS_UNSPECIFIED       ReservationStatus = 0
+	ReservationStatus_RESERVATION_STATUS_PENDING           ReservationStatus = 1
+	ReservationStatus_RESERVATION_STATUS_APPROVED          ReservationStatus = 2
+	ReservationStatus_RESERVATION_STATUS_CANCELED_BY_GUEST ReservationStatus = 3
+	ReservationStatus_RESERVATION_STATUS_DECLINED_BY_HOST  ReservationStatus = 4
+	ReservationStatus_RESERVATION_STATUS_COMPLETED         ReservationStatus = 5
+	ReservationStatus_RESERVATION_STATUS_EXPIRED           ReservationStatus = 6
+)
+
+// Enum value maps for ReservationStatus.
+var (
+	ReservationStatus_name = map[int32]string{
+		0: "RESERVATION_STATUS_UNSPECIFIED",
+		1: "RESERVATION_STATUS_PENDING",
+		2: "RESERVATION_STATUS_APPROVED",
+		3: "RESERVATION_STATUS_CANCELED_BY_GUEST",
+		4: "RESERVATION_STATUS_DECLINED_BY_HOST",
+		5: "RESERVATION_STATUS_COMPLETED",
+		6: "RESERVATION_STATUS_EXPIRED",
+	}
+	ReservationStatus_value = map[string]int32{
+		"RESERVATION_STATUS_UNSPECIFIED":       0,
+		"RESERVATION_STATUS_PENDING":           1,
+		"RESERVATION_STATUS_APPROVED":          2,
+		"RESERVATION_STATUS_CANCELED_BY_GUEST": 3,
+		"RESERVATION_STATUS_DECLINED_BY_HOST":  4,
+		"RESERVATION_STATUS_COMPLETED":         5,
+		"RESERVATION_STATUS_EXPIRED":           6,
+	}
+)
+
+func (x ReservationStatus) Enum() *ReservationStatus {
+	p := new(ReservationStatus)
+	*p = x
+	return p
+}
+
+func (x ReservationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReservationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_reservation_events_proto_enumTypes[1].Descriptor()
+}
+
+func (ReservationStatus) Type() protoreflect.EnumType {
+	return &file_reservation_events_proto_enumTypes[1]
+}
+
+func (x ReservationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReservationStatus.Descriptor instead.
+func (ReservationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{1}
+}
+
+type BlockReason int32
+
+const (
+	BlockReason_BLOCK_REASON_UNSPECIFIED BlockReason = 0
+	BlockReason_BLOCK_REASON_RESERVED    BlockReason = 1
+	BlockReason_BLOCK_REASON_OWNER       BlockReason = 2
+)
+
+// Enum value maps for BlockReason.
+var (
+	BlockReason_name = map[int32]string{
+		0: "BLOCK_REASON_UNSPECIFIED",
+		1: "BLOCK_REASON_RESERVED",
+		2: "BLOCK_REASON_OWNER",
+	}
+	BlockReason_value = map[string]int32{
+		"BLOCK_REASON_UNSPECIFIED": 0,
+		"BLOCK_REASON_RESERVED":    1,
+		"BLOCK_REASON_OWNER":       2,
+	}
+)
+
+func (x BlockReason) Enum() *BlockReason {
+	p := new(BlockReason)
+	*p = x
+	return p
+}
+
+func (x BlockReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlockReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_reservation_events_proto_enumTypes[2].Descriptor()
+}
+
+func (BlockReason) Type() protoreflect.EnumType {
+	return &file_reservation_events_proto_enumTypes[2]
+}
+
+func (x BlockReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlockReason.Descriptor instead.
+func (BlockReason) EnumDescriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{2}
+}
+
+type EventMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId       string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     string `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	SchemaVersion int32  `protobuf:"varint,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	OccurredAt    string `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Actor         *Actor `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (x *EventMetadata) Reset() {
+	*x = EventMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reservation_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventMetadata) ProtoMessage() {}
+
+func (x *EventMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventMetadata.ProtoReflect.Descriptor instead.
+func (*EventMetadata) Descriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventMetadata) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventMetadata) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *EventMetadata) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *EventMetadata) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *EventMetadata) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+type Actor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role ActorRole `protobuf:"varint,1,opt,name=role,proto3,enum=booking.ActorRole" json:"role,omitempty"`
+	Id   string    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Actor) Reset() {
+	*x = Actor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reservation_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Actor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Actor) ProtoMessage() {}
+
+func (x *Actor) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Actor.ProtoReflect.Descriptor instead.
+func (*Actor) Descriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Actor) GetRole() ActorRole {
+	if x != nil {
+		return x.Role
+	}
+	return ActorRole_ACTOR_ROLE_UNSPECIFIED
+}
+
+func (x *Actor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReservationSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReservationId     string            `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	AccommodationId   string            `protobuf:"bytes,2,opt,name=accommodation_id,json=accommodationId,proto3" json:"accommodation_id,omitempty"`
+	AccommodationName string            `protobuf:"bytes,3,opt,name=accommodation_name,json=accommodationName,proto3" json:"accommodation_name,omitempty"`
+	HostId            string            `protobuf:"bytes,4,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	GuestId           string            `protobuf:"bytes,5,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	CheckInDate       string            `protobuf:"bytes,6,opt,name=check_in_date,json=checkInDate,proto3" json:"check_in_date,omitempty"`
+	CheckOutDate      string            `protobuf:"bytes,7,opt,name=check_out_date,json=checkOutDate,proto3" json:"check_out_date,omitempty"`
+	Start             string            `protobuf:"bytes,8,opt,name=start,proto3" json:"start,omitempty"`
+	End               string            `protobuf:"bytes,9,opt,name=end,proto3" json:"end,omitempty"`
+	NumberOfGuests    int32             `protobuf:"varint,10,opt,name=number_of_guests,json=numberOfGuests,proto3" json:"number_of_guests,omitempty"`
+	PriceTotal        *Money            `protobuf:"bytes,11,opt,name=price_total,json=priceTotal,proto3" json:"price_total,omitempty"`
+	Status            ReservationStatus `protobuf:"varint,12,opt,name=status,proto3,enum=booking.ReservationStatus" json:"status,omitempty"`
+	ExpiresAt         string            `protobuf:"bytes,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RefundAmount      *Money            `protobuf:"bytes,14,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	CanceledAt        string            `protobuf:"bytes,15,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"`
+}
+
+func (x *ReservationSnapshot) Reset() {
+	*x = ReservationSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reservation_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationSnapshot) ProtoMessage() {}
+
+func (x *ReservationSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationSnapshot.ProtoReflect.Descriptor instead.
+func (*ReservationSnapshot) Descriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReservationSnapshot) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReservationSnapshot) GetAccommodationId() string {
+	if x != nil {
+		return x.AccommodationId
+	}
+	return ""
+}
+
+func (x *ReservationSnapshot) GetAccommodationName() string {
+	if x != nil {
+		return x.AccommodationName
+	}
+	return ""
+}
+
+func (x *ReservationSnapshot) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *ReservationSnapshot) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
+func (x *ReservationSnapshot) GetCheckInDate() string {
+	if x != nil {
+		return x.CheckInDate
+	}
+	return ""
+}
+
+func (x *ReservationSnapshot) GetCheckOutDate() string {
+	if x != nil {
+		return x.CheckOutDate
+	}
+	return ""
+}
+
+func (x *ReservationSnapshot) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ReservationSnapshot) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *ReservationSnapshot) GetNumberOfGuests() int32 {
+	if x != nil {
+		return x.NumberOfGuests
+	}
+	return 0
+}
+
+func (x *ReservationSnapshot) GetPriceTotal() *Money {
+	if x != nil {
+		return x.PriceTotal
+	}
+	return nil
+}
+
+func (x *ReservationSnapshot) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+}
+
+func (x *ReservationSnapshot) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *ReservationSnapshot) GetRefundAmount() *Money {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return nil
+}
+
+func (x *ReservationSnapshot) GetCanceledAt() string {
+	if x != nil {
+		return x.CanceledAt
+	}
+	return ""
+}
+
+type ReservationRequested struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservation     *ReservationSnapshot `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	AutomaticReview bool                 `protobuf:"varint,2,opt,name=automatic_review,json=automaticReview,proto3" json:"automatic_review,omitempty"`
+}
+
+func (x *ReservationRequested) Reset() {
+	*x = ReservationRequested{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reservation_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationRequested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationRequested) ProtoMessage() {}
+
+func (x *ReservationRequested) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationRequested.ProtoReflect.Descriptor instead.
+func (*ReservationRequested) Descriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReservationRequested) GetReservation() *ReservationSnapshot {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+func (x *ReservationRequested) GetAutomaticReview() bool {
+	if x != nil {
+		return x.AutomaticReview
+	}
+	return false
+}
+
+type ReservationApproved struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservation        *ReservationSnapshot `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	Automatic          bool                 `protobuf:"varint,2,opt,name=automatic,proto3" json:"automatic,omitempty"`
+	DeclinedRequestIds []string             `protobuf:"bytes,3,rep,name=declined_request_ids,json=declinedRequestIds,proto3" json:"declined_request_ids,omitempty"`
+}
+
+func (x *ReservationApproved) Reset() {
+	*x = ReservationApproved{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reservation_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationApproved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationApproved) ProtoMessage() {}
+
+func (x *ReservationApproved) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationApproved.ProtoReflect.Descriptor instead.
+func (*ReservationApproved) Descriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReservationApproved) GetReservation() *ReservationSnapshot {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+func (x *ReservationApproved) GetAutomatic() bool {
+	if x != nil {
+		return x.Automatic
+	}
+	return false
+}
+
+func (x *ReservationApproved) GetDeclinedRequestIds() []string {
+	if x != nil {
+		return x.DeclinedRequestIds
+	}
+	return nil
+}
+
+type ReservationDeclined struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservation *ReservationSnapshot `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+}
+
+func (x *ReservationDeclined) Reset() {
+	*x = ReservationDeclined{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reservation_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationDeclined) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationDeclined) ProtoMessage() {}
+
+func (x *ReservationDeclined) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationDeclined.ProtoReflect.Descriptor instead.
+func (*ReservationDeclined) Descriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReservationDeclined) GetReservation() *ReservationSnapshot {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type ReservationCanceledByGuest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservation       *ReservationSnapshot `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	RefundAmount      *Money               `protobuf:"bytes,2,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	DaysBeforeCheckIn int32                `protobuf:"varint,3,opt,name=days_before_check_in,json=daysBeforeCheckIn,proto3" json:"days_before_check_in,omitempty"`
+}
+
+func (x *ReservationCanceledByGuest) Reset() {
+	*x = ReservationCanceledByGuest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reservation_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationCanceledByGuest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationCanceledByGuest) ProtoMessage() {}
+
+func (x *ReservationCanceledByGuest) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationCanceledByGuest.ProtoReflect.Descriptor instead.
+func (*ReservationCanceledByGuest) Descriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReservationCanceledByGuest) GetReservation() *ReservationSnapshot {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+func (x *ReservationCanceledByGuest) GetRefundAmount() *Money {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return nil
+}
+
+func (x *ReservationCanceledByGuest) GetDaysBeforeCheckIn() int32 {
+	if x != nil {
+		return x.DaysBeforeCheckIn
+	}
+	return 0
+}
+
+type ReservationCompleted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservation *ReservationSnapshot `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+}
+
+func (x *ReservationCompleted) Reset() {
+	*x = ReservationCompleted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reservation_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationCompleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationCompleted) ProtoMessage() {}
+
+func (x *ReservationCompleted) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationCompleted.ProtoReflect.Descriptor instead.
+func (*ReservationCompleted) Descriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReservationCompleted) GetReservation() *ReservationSnapshot {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type ReservationExpired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservation *ReservationSnapshot `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+}
+
+func (x *ReservationExpired) Reset() {
+	*x = ReservationExpired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reservation_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationExpired) ProtoMessage() {}
+
+func (x *ReservationExpired) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_events_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationExpired.ProtoReflect.Descriptor instead.
+func (*ReservationExpired) Descriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReservationExpired) GetReservation() *ReservationSnapshot {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type ReservationEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *EventMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Types that are assignable to Event:
+	//	*ReservationEvent_Requested
+	//	*ReservationEvent_Approved
+	//	*ReservationEvent_Declined
+	//	*ReservationEvent_CanceledByGuest
+	//	*ReservationEvent_Completed
+	//	*ReservationEvent_Expired
+	Event isReservationEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *ReservationEvent) Reset() {
+	*x = ReservationEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reservation_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationEvent) ProtoMessage() {}
+
+func (x *ReservationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_events_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationEvent.ProtoReflect.Descriptor instead.
+func (*ReservationEvent) Descriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReservationEvent) GetMetadata() *EventMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (m *ReservationEvent) GetEvent() isReservationEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *ReservationEvent) GetRequested() *ReservationRequested {
+	if x, ok := x.GetEvent().(*ReservationEvent_Requested); ok {
+		return x.Requested
+	}
+	return nil
+}
+
+func (x *ReservationEvent) GetApproved() *ReservationApproved {
+	if x, ok := x.GetEvent().(*ReservationEvent_Approved); ok {
+		return x.Approved
+	}
+	return nil
+}
+
+func (x *ReservationEvent) GetDeclined() *ReservationDeclined {
+	if x, ok := x.GetEvent().(*ReservationEvent_Declined); ok {
+		return x.Declined
+	}
+	return nil
+}
+
+func (x *ReservationEvent) GetCanceledByGuest() *ReservationCanceledByGuest {
+	if x, ok := x.GetEvent().(*ReservationEvent_CanceledByGuest); ok {
+		return x.CanceledByGuest
+	}
+	return nil
+}
+
+func (x *ReservationEvent) GetCompleted() *ReservationCompleted {
+	if x, ok := x.GetEvent().(*ReservationEvent_Completed); ok {
+		return x.Completed
+	}
+	return nil
+}
+
+func (x *ReservationEvent) GetExpired() *ReservationExpired {
+	if x, ok := x.GetEvent().(*ReservationEvent_Expired); ok {
+		return x.Expired
+	}
+	return nil
+}
+
+type isReservationEvent_Event interface {
+	isReservationEvent_Event()
+}
+
+type ReservationEvent_Requested struct {
+	Requested *ReservationRequested `protobuf:"bytes,2,opt,name=requested,proto3,oneof"`
+}
+
+type ReservationEvent_Approved struct {
+	Approved *ReservationApproved `protobuf:"bytes,3,opt,name=approved,proto3,oneof"`
+}
+
+type ReservationEvent_Declined struct {
+	Declined *ReservationDeclined `protobuf:"bytes,4,opt,name=declined,proto3,oneof"`
+}
+
+type ReservationEvent_CanceledByGuest struct {
+	CanceledByGuest *ReservationCanceledByGuest `protobuf:"bytes,5,opt,name=canceled_by_guest,json=canceledByGuest,proto3,oneof"`
+}
+
+type ReservationEvent_Completed struct {
+	Completed *ReservationCompleted `protobuf:"bytes,6,opt,name=completed,proto3,oneof"`
+}
+
+type ReservationEvent_Expired struct {
+	Expired *ReservationExpired `protobuf:"bytes,7,opt,name=expired,proto3,oneof"`
+}
+
+func (*ReservationEvent_Requested) isReservationEvent_Event() {}
+
+func (*ReservationEvent_Approved) isReservationEvent_Event() {}
+
+func (*ReservationEvent_Declined) isReservationEvent_Event() {}
+
+func (*ReservationEvent_CanceledByGuest) isReservationEvent_Event() {}
+
+func (*ReservationEvent_Completed) isReservationEvent_Event() {}
+
+func (*ReservationEvent_Expired) isReservationEvent_Event() {}
+
+type BlockedPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccommodationId string      `protobuf:"bytes,1,opt,name=accommodation_id,json=accommodationId,proto3" json:"accommodation_id,omitempty"`
+	HostId          string      `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	PeriodId        string      `protobuf:"bytes,3,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
+	CheckInDate     string      `protobuf:"bytes,4,opt,name=check_in_date,json=checkInDate,proto3" json:"check_in_date,omitempty"`
+	CheckOutDate    string      `protobuf:"bytes,5,opt,name=check_out_date,json=checkOutDate,proto3" json:"check_out_date,omitempty"`
+	Start           string      `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	End             string      `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
+	Reason          BlockReason `protobuf:"varint,8,opt,name=reason,proto3,enum=booking.BlockReason" json:"reason,omitempty"`
+	ReservationId   string      `protobuf:"bytes,9,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+}
+
+func (x *BlockedPeriod) Reset() {
+	*x = BlockedPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reservation_events_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockedPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedPeriod) ProtoMessage() {}
+
+func (x *BlockedPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_events_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedPeriod.ProtoReflect.Descriptor instead.
+func (*BlockedPeriod) Descriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BlockedPeriod) GetAccommodationId() string {
+	if x != nil {
+		return x.AccommodationId
+	}
+	return ""
+}
+
+func (x *BlockedPeriod) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *BlockedPeriod) GetPeriodId() string {
+	if x != nil {
+		return x.PeriodId
+	}
+	return ""
+}
+
+func (x *BlockedPeriod) GetCheckInDate() string {
+	if x != nil {
+		return x.CheckInDate
+	}
+	return ""
+}
+
+func (x *BlockedPeriod) GetCheckOutDate() string {
+	if x != nil {
+		return x.CheckOutDate
+	}
+	return ""
+}
+
+func (x *BlockedPeriod) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *BlockedPeriod) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *BlockedPeriod) GetReason() BlockReason {
+	if x != nil {
+		return x.Reason
+	}
+	return BlockReason_BLOCK_REASON_UNSPECIFIED
+}
+
+func (x *BlockedPeriod) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type PeriodBlocked struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period *BlockedPeriod `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (x *PeriodBlocked) Reset() {
+	*x = PeriodBlocked{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reservation_events_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeriodBlocked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodBlocked) ProtoMessage() {}
+
+func (x *PeriodBlocked) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_events_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodBlocked.ProtoReflect.Descriptor instead.
+func (*PeriodBlocked) Descriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PeriodBlocked) GetPeriod() *BlockedPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+type PeriodUnblocked struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period *BlockedPeriod `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (x *PeriodUnblocked) Reset() {
+	*x = PeriodUnblocked{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reservation_events_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeriodUnblocked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodUnblocked) ProtoMessage() {}
+
+func (x *PeriodUnblocked) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_events_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodUnblocked.ProtoReflect.Descriptor instead.
+func (*PeriodUnblocked) Descriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PeriodUnblocked) GetPeriod() *BlockedPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+type AvailabilityEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *EventMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Types that are assignable to Event:
+	//	*AvailabilityEvent_Blocked
+	//	*AvailabilityEvent_Unblocked
+	Event isAvailabilityEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *AvailabilityEvent) Reset() {
+	*x = AvailabilityEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reservation_events_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailabilityEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityEvent) ProtoMessage() {}
+
+func (x *AvailabilityEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_reservation_events_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityEvent.ProtoReflect.Descriptor instead.
+func (*AvailabilityEvent) Descriptor() ([]byte, []int) {
+	return file_reservation_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AvailabilityEvent) GetMetadata() *EventMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (m *AvailabilityEvent) GetEvent() isAvailabilityEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *AvailabilityEvent) GetBlocked() *PeriodBlocked {
+	if x, ok := x.GetEvent().(*AvailabilityEvent_Blocked); ok {
+		return x.Blocked
+	}
+	return nil
+}
+
+func (x *AvailabilityEvent) GetUnblocked() *PeriodUnblocked {
+	if x, ok := x.GetEvent().(*AvailabilityEvent_Unblocked); ok {
+		return x.Unblocked
+	}
+	return nil
+}
+
+type isAvailabilityEvent_Event interface {
+	isAvailabilityEvent_Event()
+}
+
+type AvailabilityEvent_Blocked struct {
+	Blocked *PeriodBlocked `protobuf:"bytes,2,opt,name=blocked,proto3,oneof"`
+}
+
+type AvailabilityEvent_Unblocked struct {
+	Unblocked *PeriodUnblocked `protobuf:"bytes,3,opt,name=unblocked,proto3,oneof"`
+}
+
+func (*AvailabilityEvent_Blocked) isAvailabilityEvent_Event() {}
+
+func (*AvailabilityEvent_Unblocked) isAvailabilityEvent_Event() {}
+
+var File_reservation_events_proto protoreflect.FileDescriptor
+
+var file_reservation_events_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x1a, 0x15, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb7, 0x01, 0x0a, 0x0d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24,
+	0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x22, 0x3f, 0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc0, 0x04, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x61, 0x63, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x2d, 0x0a, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x63, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x49, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f,
+	0x6f, 0x75, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f,
+	0x66, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x47, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2f,
+	0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x12, 0x33, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x12, 0x3e, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x75, 0x74,
+	0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0xa5, 0x01, 0x0a,
+	0x13, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x12, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x73, 0x22, 0x55, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0b, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x0b,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc2, 0x01, 0x0a, 0x1a,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x65, 0x64, 0x42, 0x79, 0x47, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x0b, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0d, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2f, 0x0a, 0x14, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x64,
+	0x61, 0x79, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e,
+	0x22, 0x56, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x0b, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x3e,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd1,
+	0x03, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x3a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x08, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x51,
+	0x0a, 0x11, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x67, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x47, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x0f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x47, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3d, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x12, 0x37, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0xb7, 0x02, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x61, 0x63, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69,
+	0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x49, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x0d,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x2e, 0x0a,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x41, 0x0a,
+	0x0f, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x12, 0x2e, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x22, 0xbe, 0x01, 0x0a, 0x11, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x07, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x38,
+	0x0a, 0x09, 0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x75,
+	0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x2a, 0x69, 0x0a, 0x09, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1a,
+	0x0a, 0x16, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x43,
+	0x54, 0x4f, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x47, 0x55, 0x45, 0x53, 0x54, 0x10, 0x01,
+	0x12, 0x13, 0x0a, 0x0f, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x48,
+	0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x03, 0x2a, 0x8d, 0x02, 0x0a,
+	0x11, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x50, 0x50,
+	0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x28, 0x0a, 0x24, 0x52, 0x45, 0x53, 0x45, 0x52,
+	0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x47, 0x55, 0x45, 0x53, 0x54, 0x10,
+	0x03, 0x12, 0x27, 0x0a, 0x23, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44,
+	0x5f, 0x42, 0x59, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45,
+	0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a,
+	0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x5e, 0x0a, 0x0b,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10, 0x02, 0x42, 0x0f, 0x5a, 0x0d,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_reservation_events_proto_rawDescOnce sync.Once
+	file_reservation_events_proto_rawDescData = file_reservation_events_proto_rawDesc
+)
+
+func file_reservation_events_proto_rawDescGZIP() []byte {
+	file_reservation_events_proto_rawDescOnce.Do(func() {
+		file_reservation_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_reservation_events_proto_rawDescData)
+	})
+	return file_reservation_events_proto_rawDescData
+}
+
+var file_reservation_events_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_reservation_events_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_reservation_events_proto_goTypes = []interface{}{
+	(ActorRole)(0),                     // 0: booking.ActorRole
+	(ReservationStatus)(0),             // 1: booking.ReservationStatus
+	(BlockReason)(0),                   // 2: booking.BlockReason
+	(*EventMetadata)(nil),              // 3: booking.EventMetadata
+	(*Actor)(nil),                      // 4: booking.Actor
+	(*ReservationSnapshot)(nil),        // 5: booking.ReservationSnapshot
+	(*ReservationRequested)(nil),       // 6: booking.ReservationRequested
+	(*ReservationApproved)(nil),        // 7: booking.ReservationApproved
+	(*ReservationDeclined)(nil),        // 8: booking.ReservationDeclined
+	(*ReservationCanceledByGuest)(nil), // 9: booking.ReservationCanceledByGuest
+	(*ReservationCompleted)(nil),       // 10: booking.ReservationCompleted
+	(*ReservationExpired)(nil),         // 11: booking.ReservationExpired
+	(*ReservationEvent)(nil),           // 12: booking.ReservationEvent
+	(*BlockedPeriod)(nil),              // 13: booking.BlockedPeriod
+	(*PeriodBlocked)(nil),              // 14: booking.PeriodBlocked
+	(*PeriodUnblocked)(nil),            // 15: booking.PeriodUnblocked
+	(*AvailabilityEvent)(nil),          // 16: booking.AvailabilityEvent
+	(*Money)(nil),                      // 17: booking.Money
+}
+var file_reservation_events_proto_depIdxs = []int32{
+	4,  // 0: booking.EventMetadata.actor:type_name -> booking.Actor
+	0,  // 1: booking.Actor.role:type_name -> booking.ActorRole
+	17, // 2: booking.ReservationSnapshot.price_total:type_name -> booking.Money
+	1,  // 3: booking.ReservationSnapshot.status:type_name -> booking.ReservationStatus
+	17, // 4: booking.ReservationSnapshot.refund_amount:type_name -> booking.Money
+	5,  // 5: booking.ReservationRequested.reservation:type_name -> booking.ReservationSnapshot
+	5,  // 6: booking.ReservationApproved.reservation:type_name -> booking.ReservationSnapshot
+	5,  // 7: booking.ReservationDeclined.reservation:type_name -> booking.ReservationSnapshot
+	5,  // 8: booking.ReservationCanceledByGuest.reservation:type_name -> booking.ReservationSnapshot
+	17, // 9: booking.ReservationCanceledByGuest.refund_amount:type_name -> booking.Money
+	5,  // 10: booking.ReservationCompleted.reservation:type_name -> booking.ReservationSnapshot
+	5,  // 11: booking.ReservationExpired.reservation:type_name -> booking.ReservationSnapshot
+	3,  // 12: booking.ReservationEvent.metadata:type_name -> booking.EventMetadata
+	6,  // 13: booking.ReservationEvent.requested:type_name -> booking.ReservationRequested
+	7,  // 14: booking.ReservationEvent.approved:type_name -> booking.ReservationApproved
+	8,  // 15: booking.ReservationEvent.declined:type_name -> booking.ReservationDeclined
+	9,  // 16: booking.ReservationEvent.canceled_by_guest:type_name -> booking.ReservationCanceledByGuest
+	10, // 17: booking.ReservationEvent.completed:type_name -> booking.ReservationCompleted
+	11, // 18: booking.ReservationEvent.expired:type_name -> booking.ReservationExpired
+	2,  // 19: booking.BlockedPeriod.reason:type_name -> booking.BlockReason
+	13, // 20: booking.PeriodBlocked.period:type_name -> booking.BlockedPeriod
+	13, // 21: booking.PeriodUnblocked.period:type_name -> booking.BlockedPeriod
+	3,  // 22: booking.AvailabilityEvent.metadata:type_name -> booking.EventMetadata
+	14, // 23: booking.AvailabilityEvent.blocked:type_name -> booking.PeriodBlocked
+	15, // 24: booking.AvailabilityEvent.unblocked:type_name -> booking.PeriodUnblocked
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_reservation_events_proto_init() }
+func file_reservation_events_proto_init() {
+	if File_reservation_events_proto != nil {
+		return
+	}
+	file_booking_service_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_reservation_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reservation_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Actor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reservation_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservationSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reservation_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservationRequested); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reservation_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservationApproved); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reservation_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservationDeclined); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reservation_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservationCanceledByGuest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reservation_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservationCompleted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reservation_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservationExpired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reservation_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservationEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reservation_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockedPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reservation_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeriodBlocked); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reservation_events_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeriodUnblocked); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reservation_events_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvailabilityEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_reservation_events_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*ReservationEvent_Requested)(nil),
+		(*ReservationEvent_Approved)(nil),
+		(*ReservationEvent_Declined)(nil),
+		(*ReservationEvent_CanceledByGuest)(nil),
+		(*ReservationEvent_Completed)(nil),
+		(*ReservationEvent_Expired)(nil),
+	}
+	file_reservation_events_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*AvailabilityEvent_Blocked)(nil),
+		(*AvailabilityEvent_Unblocked)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_reservation_events_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_reservation_events_proto_goTypes,
+		DependencyIndexes: file_reservation_events_proto_depIdxs,
+		EnumInfos:         file_reservation_events_proto_enumTypes,
+		MessageInfos:      file_reservation_events_proto_msgTypes,
+	}.Build()
+	File_reservation_events_proto = out.File
+	file_reservation_events_proto_rawDesc = nil
+	file_reservation_events_proto_goTypes = nil
+	file_reservation_events_proto_depIdxs = nil
+}
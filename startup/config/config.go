@@ -1,31 +1,80 @@
 package config
 
-import "os"
+import (
+	"os"
+	"strconv"
+	"time"
+)
 
 type Config struct {
-	Port              string
-	GrpcPort          string
-	BookingDBHost     string
-	BookingDBPort     string
-	BookingDBUsername string
-	BookingDBPassword string
-	BootstrapServers  string
-	KafkaAuthPassword string
-	JaegerHost        string
-	LokiHost          string
+	Port                         string
+	GrpcPort                     string
+	BookingDBHost                string
+	BookingDBPort                string
+	BookingDBUsername            string
+	BookingDBPassword            string
+	BootstrapServers             string
+	KafkaAuthPassword            string
+	JaegerHost                   string
+	LokiHost                     string
+	ReservationLifecycleInterval time.Duration
+	DefaultCurrency              string
+	ExternalCalendarSyncInterval time.Duration
+	ExternalCalendarFetchTimeout time.Duration
+	ExternalCalendarAllowPrivate bool
+	OutboxRelayInterval          time.Duration
+	EventPublisher               string
+	KafkaConsumerEnabled         bool
+	KafkaConsumerGroup           string
+	KafkaAutoOffsetReset         string
 }
 
 func NewConfig() *Config {
+	// Consumers are on by default only with the kafka publisher; the others run without a broker.
+	eventPublisher := getString("EVENT_PUBLISHER", "kafka")
 	return &Config{
-		Port:              os.Getenv("SERVICE_PORT"),
-		BookingDBHost:     os.Getenv("DB_HOST"),
-		BookingDBPort:     os.Getenv("DB_PORT"),
-		BookingDBUsername: os.Getenv("MONGO_INITDB_ROOT_USERNAME"),
-		BookingDBPassword: os.Getenv("MONGO_INITDB_ROOT_PASSWORD"),
-		GrpcPort:          os.Getenv("GRPC_PORT"),
-		BootstrapServers:  os.Getenv("KAFKA_BOOTSTRAP_SERVERS"),
-		KafkaAuthPassword: os.Getenv("KAFKA_AUTH_PASSWORD"),
-		JaegerHost:        os.Getenv("JAEGER_ENDPOINT"),
-		LokiHost:          os.Getenv("LOKI_ENDPOINT"),
+		Port:                         os.Getenv("SERVICE_PORT"),
+		BookingDBHost:                os.Getenv("DB_HOST"),
+		BookingDBPort:                os.Getenv("DB_PORT"),
+		BookingDBUsername:            os.Getenv("MONGO_INITDB_ROOT_USERNAME"),
+		BookingDBPassword:            os.Getenv("MONGO_INITDB_ROOT_PASSWORD"),
+		GrpcPort:                     os.Getenv("GRPC_PORT"),
+		BootstrapServers:             os.Getenv("KAFKA_BOOTSTRAP_SERVERS"),
+		KafkaAuthPassword:            os.Getenv("KAFKA_AUTH_PASSWORD"),
+		JaegerHost:                   os.Getenv("JAEGER_ENDPOINT"),
+		LokiHost:                     os.Getenv("LOKI_ENDPOINT"),
+		ReservationLifecycleInterval: getDuration("RESERVATION_LIFECYCLE_INTERVAL", time.Minute),
+		DefaultCurrency:              getString("DEFAULT_CURRENCY", "EUR"),
+		ExternalCalendarSyncInterval: getDuration("EXTERNAL_CALENDAR_SYNC_INTERVAL", 30*time.Minute),
+		ExternalCalendarFetchTimeout: getDuration("EXTERNAL_CALENDAR_FETCH_TIMEOUT", 10*time.Second),
+		ExternalCalendarAllowPrivate: getBool("EXTERNAL_CALENDAR_ALLOW_PRIVATE_HOSTS", false),
+		OutboxRelayInterval:          getDuration("OUTBOX_RELAY_INTERVAL", time.Second),
+		EventPublisher:               eventPublisher,
+		KafkaConsumerEnabled:         getBool("KAFKA_CONSUMER_ENABLED", eventPublisher == "kafka"),
+		KafkaConsumerGroup:           getString("KAFKA_CONSUMER_GROUP", "booking-service"),
+		KafkaAutoOffsetReset:         getString("KAFKA_AUTO_OFFSET_RESET", "earliest"),
 	}
 }
+
+func getDuration(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func getBool(key string, defaultValue bool) bool {
+	value, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+func getString(key string, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
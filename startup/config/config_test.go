@@ -0,0 +1,13 @@
+package config
+
+import "testing"
+
+func TestKafkaConsumersDefaultToEventPublisher(t *testing.T) {
+	for publisher, want := range map[string]bool{"kafka": true, "memory": false, "log": false} {
+		t.Setenv("EVENT_PUBLISHER", publisher)
+		t.Setenv("KAFKA_CONSUMER_ENABLED", "")
+		if got := NewConfig().KafkaConsumerEnabled; got != want {
+			t.Errorf("consumers enabled is %v with the %s publisher, want %v", got, publisher, want)
+		}
+	}
+}
@@ -16,7 +16,7 @@ import (
 //		Start:             time.Date(2022, time.September, 10, 15, 0, 0, 0, time.UTC),
 //		End:               time.Date(2022, time.September, 17, 12, 0, 0, 0, time.UTC),
 //		NumberOfGuests:    6,
-//		PriceTotal:        1200.00,
+//		PriceTotal:        domain.Money{Amount: 120000, Currency: "EUR"},
 //		Status:            2,
 //	},
 //}
@@ -1,7 +1,15 @@
 package startup
 
 import (
+	"context"
+	"expvar"
 	"fmt"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/ical"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/messaging"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/persistence/accommodation_tombstone"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/persistence/lease"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/persistence/outbox"
+	"github.com/ZMS-DevOps/booking-service/infrastructure/persistence/processed_event"
 	"github.com/ZMS-DevOps/booking-service/infrastructure/persistence/reservation_request"
 	"github.com/ZMS-DevOps/booking-service/infrastructure/persistence/unavailability"
 	booking "github.com/ZMS-DevOps/booking-service/proto"
@@ -17,6 +25,7 @@ import (
 	"github.com/ZMS-DevOps/booking-service/infrastructure/api"
 	"github.com/ZMS-DevOps/booking-service/infrastructure/persistence"
 	"github.com/ZMS-DevOps/booking-service/startup/config"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"log"
 	"net/http"
@@ -39,17 +48,43 @@ func NewServer(config *config.Config, traceProvider *sdktrace.TracerProvider, lo
 	return server
 }
 
-func (server *Server) Start(producer *kafka.Producer) {
+func (server *Server) Start() {
 	mongoClient := server.initMongoClient()
 	unavailabilityStore := server.initUnavailabilityStore(mongoClient)
 	reservationRequestStore := server.initReservationRequestStore(mongoClient)
-	unavailabilityService := server.initUnavailabilityService(unavailabilityStore, producer, reservationRequestStore)
-	reservationRequestService := server.initReservationRequestService(reservationRequestStore, unavailabilityService, producer)
+	outboxStore := server.initOutboxStore(mongoClient)
+	transactionManager := server.initTransactionManager(mongoClient)
+	calendarFetcher := server.initCalendarFeedFetcher()
+	tombstoneStore := server.initAccommodationTombstoneStore(mongoClient)
+	unavailabilityService := server.initUnavailabilityService(unavailabilityStore, transactionManager, outboxStore, reservationRequestStore, calendarFetcher, tombstoneStore)
+	reservationRequestService := server.initReservationRequestService(reservationRequestStore, unavailabilityService, transactionManager, outboxStore)
 	unavailabilityHandler := server.initUnavailabilityHandler(unavailabilityService)
 	reservationRequestHandler := server.initReservationRequestHandler(reservationRequestService)
+	calendarHandler := server.initCalendarHandler(unavailabilityService)
+	recurringBlockHandler := server.initRecurringBlockHandler(unavailabilityService)
+	externalCalendarHandler := server.initExternalCalendarHandler(unavailabilityService)
 	unavailabilityHandler.Init(server.router)
 	reservationRequestHandler.Init(server.router)
+	calendarHandler.Init(server.router)
+	recurringBlockHandler.Init(server.router)
+	externalCalendarHandler.Init(server.router)
+	server.router.Handle("/debug/vars", expvar.Handler()).Methods("GET")
 	grpcHandler := server.initGrpcHandler(unavailabilityService, reservationRequestService)
+	leaseStore := server.initLeaseStore(mongoClient)
+	lifecycleScheduler := server.initReservationLifecycleScheduler(reservationRequestService, leaseStore)
+	go lifecycleScheduler.Start(context.Background())
+	externalCalendarSyncScheduler := server.initExternalCalendarSyncScheduler(unavailabilityService, leaseStore)
+	go externalCalendarSyncScheduler.Start(context.Background())
+	eventPublisher := server.initEventPublisher()
+	outboxRelay := server.initOutboxRelay(outboxStore, eventPublisher, leaseStore)
+	go outboxRelay.Start(context.Background())
+	if server.config.KafkaConsumerEnabled {
+		processedEventStore := server.initProcessedEventStore(mongoClient)
+		accommodationEventConsumer := server.initAccommodationEventConsumer(unavailabilityService, reservationRequestService, processedEventStore, transactionManager)
+		go accommodationEventConsumer.Start(context.Background())
+		userEventConsumer := server.initUserEventConsumer(reservationRequestService, processedEventStore, transactionManager)
+		go userEventConsumer.Start(context.Background())
+	}
 	go server.startGrpcServer(grpcHandler)
 	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%s", server.config.Port), server.router))
 }
@@ -63,6 +98,9 @@ func (server *Server) initMongoClient() *mongo.Client {
 }
 
 func (server *Server) initUnavailabilityStore(client *mongo.Client) domain.UnavailabilityStore {
+	if err := unavailability.EnsureIndexes(client); err != nil {
+		log.Fatal(err)
+	}
 	store := unavailability.NewUnavailabilityMongoDBStore(client)
 	store.DeleteAll()
 	for _, unavailability := range unavailabilities {
@@ -75,8 +113,13 @@ func (server *Server) initUnavailabilityStore(client *mongo.Client) domain.Unava
 }
 
 func (server *Server) initReservationRequestStore(client *mongo.Client) domain.ReservationRequestStore {
+	if err := reservation_request.MigrateMoneyFields(client, server.config.DefaultCurrency); err != nil {
+		log.Fatal(err)
+	}
+	if err := reservation_request.MigrateExpiryFields(client); err != nil {
+		log.Fatal(err)
+	}
 	store := reservation_request.NewReservationRequestMongoDBStore(client)
-	store.DeleteAll()
 	//for _, request := range reservationRequests {
 	//	_, err := store.Insert(request)
 	//	if err != nil {
@@ -86,6 +129,31 @@ func (server *Server) initReservationRequestStore(client *mongo.Client) domain.R
 	return store
 }
 
+func (server *Server) initOutboxStore(client *mongo.Client) domain.OutboxStore {
+	if err := outbox.EnsureIndexes(client); err != nil {
+		log.Fatal(err)
+	}
+	return outbox.NewOutboxMongoDBStore(client)
+}
+
+func (server *Server) initProcessedEventStore(client *mongo.Client) domain.ProcessedEventStore {
+	if err := processed_event.EnsureIndexes(client); err != nil {
+		log.Fatal(err)
+	}
+	return processed_event.NewProcessedEventMongoDBStore(client)
+}
+
+func (server *Server) initAccommodationTombstoneStore(client *mongo.Client) domain.AccommodationTombstoneStore {
+	if err := accommodation_tombstone.EnsureIndexes(client); err != nil {
+		log.Fatal(err)
+	}
+	return accommodation_tombstone.NewAccommodationTombstoneMongoDBStore(client)
+}
+
+func (server *Server) initLeaseStore(client *mongo.Client) domain.LeaseStore {
+	return lease.NewLeaseMongoDBStore(client)
+}
+
 func (server *Server) startGrpcServer(bookingHandler *api.BookingHandler) {
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%s", server.config.GrpcPort))
 	if err != nil {
@@ -98,12 +166,20 @@ func (server *Server) startGrpcServer(bookingHandler *api.BookingHandler) {
 	}
 }
 
-func (server *Server) initUnavailabilityService(store domain.UnavailabilityStore, producer *kafka.Producer, reservationRequestStore domain.ReservationRequestStore) *application.UnavailabilityService {
-	return application.NewUnavailabilityService(store, producer, reservationRequestStore, server.loki)
+func (server *Server) initCalendarFeedFetcher() domain.CalendarFeedFetcher {
+	return ical.NewHttpFeedFetcher(server.config.ExternalCalendarFetchTimeout, server.config.ExternalCalendarAllowPrivate)
+}
+
+func (server *Server) initUnavailabilityService(store domain.UnavailabilityStore, transactions domain.TransactionManager, outbox domain.OutboxStore, reservationRequestStore domain.ReservationRequestStore, calendarFetcher domain.CalendarFeedFetcher, tombstones domain.AccommodationTombstoneStore) *application.UnavailabilityService {
+	return application.NewUnavailabilityService(store, transactions, outbox, reservationRequestStore, calendarFetcher, tombstones, server.loki)
 }
 
-func (server *Server) initReservationRequestService(store domain.ReservationRequestStore, unavailabilityService *application.UnavailabilityService, producer *kafka.Producer) *application.ReservationRequestService {
-	return application.NewReservationRequestService(store, unavailabilityService, producer, server.loki)
+func (server *Server) initTransactionManager(client *mongo.Client) domain.TransactionManager {
+	return persistence.NewMongoTransactionManager(client)
+}
+
+func (server *Server) initReservationRequestService(store domain.ReservationRequestStore, unavailabilityService *application.UnavailabilityService, transactions domain.TransactionManager, outbox domain.OutboxStore) *application.ReservationRequestService {
+	return application.NewReservationRequestService(store, unavailabilityService, transactions, outbox, server.loki)
 }
 
 func (server *Server) initUnavailabilityHandler(service *application.UnavailabilityService) *api.UnavailabilityHandler {
@@ -114,6 +190,82 @@ func (server *Server) initReservationRequestHandler(service *application.Reserva
 	return api.NewReservationRequestHandler(service, server.traceProvider, server.loki)
 }
 
+func (server *Server) initCalendarHandler(service *application.UnavailabilityService) *api.CalendarHandler {
+	return api.NewCalendarHandler(service, server.traceProvider, server.loki)
+}
+
+func (server *Server) initRecurringBlockHandler(service *application.UnavailabilityService) *api.RecurringBlockHandler {
+	return api.NewRecurringBlockHandler(service, server.traceProvider, server.loki)
+}
+
+func (server *Server) initExternalCalendarHandler(service *application.UnavailabilityService) *api.ExternalCalendarHandler {
+	return api.NewExternalCalendarHandler(service, server.traceProvider, server.loki)
+}
+
 func (server *Server) initGrpcHandler(unavailabilityService *application.UnavailabilityService, reservationRequestService *application.ReservationRequestService) *api.BookingHandler {
 	return api.NewBookingHandler(unavailabilityService, reservationRequestService, server.traceProvider, server.loki)
 }
+
+func (server *Server) initReservationLifecycleScheduler(reservationRequestService *application.ReservationRequestService, leaseStore domain.LeaseStore) *application.ReservationLifecycleScheduler {
+	return application.NewReservationLifecycleScheduler(reservationRequestService, leaseStore, server.config.ReservationLifecycleInterval, primitive.NewObjectID().Hex(), server.traceProvider.Tracer(domain.ServiceName), server.loki)
+}
+
+func (server *Server) initExternalCalendarSyncScheduler(unavailabilityService *application.UnavailabilityService, leaseStore domain.LeaseStore) *application.ExternalCalendarSyncScheduler {
+	return application.NewExternalCalendarSyncScheduler(unavailabilityService, leaseStore, server.config.ExternalCalendarSyncInterval, primitive.NewObjectID().Hex(), server.traceProvider.Tracer(domain.ServiceName), server.loki)
+}
+
+func (server *Server) initEventPublisher() domain.EventPublisher {
+	switch server.config.EventPublisher {
+	case "kafka":
+		kafkaConfig := server.getKafkaConfig()
+		kafkaConfig["enable.idempotence"] = true
+		producer, err := kafka.NewProducer(&kafkaConfig)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return messaging.NewKafkaEventPublisher(producer)
+	case "memory":
+		return messaging.NewInMemoryEventPublisher()
+	case "log":
+		return messaging.NewLogEventPublisher()
+	default:
+		log.Fatalf("unknown event publisher %q, expected kafka, memory or log", server.config.EventPublisher)
+		return nil
+	}
+}
+
+func (server *Server) initOutboxRelay(outboxStore domain.OutboxStore, eventPublisher domain.EventPublisher, leaseStore domain.LeaseStore) *application.OutboxRelay {
+	return application.NewOutboxRelay(outboxStore, eventPublisher, leaseStore, server.config.OutboxRelayInterval, primitive.NewObjectID().Hex())
+}
+
+func (server *Server) initAccommodationEventConsumer(unavailabilityService *application.UnavailabilityService, reservationRequestService *application.ReservationRequestService, processedEventStore domain.ProcessedEventStore, transactions domain.TransactionManager) *messaging.KafkaEventConsumer {
+	handler := application.NewAccommodationEventConsumer(unavailabilityService, reservationRequestService, processedEventStore, transactions, server.traceProvider.Tracer(domain.ServiceName), server.loki)
+	return messaging.NewKafkaEventConsumer(server.newKafkaConsumer(), application.AccommodationEventTopics, handler)
+}
+
+func (server *Server) initUserEventConsumer(reservationRequestService *application.ReservationRequestService, processedEventStore domain.ProcessedEventStore, transactions domain.TransactionManager) *messaging.KafkaEventConsumer {
+	handler := application.NewUserEventConsumer(reservationRequestService, processedEventStore, transactions, server.traceProvider.Tracer(domain.ServiceName), server.loki)
+	return messaging.NewKafkaEventConsumer(server.newKafkaConsumer(), application.UserEventTopics, handler)
+}
+
+func (server *Server) newKafkaConsumer() *kafka.Consumer {
+	kafkaConfig := server.getKafkaConfig()
+	kafkaConfig["group.id"] = server.config.KafkaConsumerGroup
+	kafkaConfig["auto.offset.reset"] = server.config.KafkaAutoOffsetReset
+	kafkaConfig["enable.auto.commit"] = false
+	consumer, err := kafka.NewConsumer(&kafkaConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return consumer
+}
+
+func (server *Server) getKafkaConfig() kafka.ConfigMap {
+	return kafka.ConfigMap{
+		"bootstrap.servers": server.config.BootstrapServers,
+		"security.protocol": "sasl_plaintext",
+		"sasl.mechanism":    "PLAIN",
+		"sasl.username":     "user1",
+		"sasl.password":     server.config.KafkaAuthPassword,
+	}
+}